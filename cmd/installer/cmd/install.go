@@ -64,7 +64,7 @@ func runInstallCmd() (err error) {
 
 		warnings, err = config.Validate(p.Mode())
 		if err != nil {
-			return fmt.Errorf("machine configuration is invalid: %w", err)
+			return fmt.Errorf("machine configuration is invalid: %s: %w", err, install.ErrConfigInvalid)
 		}
 
 		if len(warnings) > 0 {
@@ -80,5 +80,9 @@ func runInstallCmd() (err error) {
 		}
 	}
 
+	options.Progress = func(event install.ProgressEvent) {
+		log.Printf("progress: %s", event)
+	}
+
 	return install.Install(p, seq, options)
 }
@@ -27,7 +27,7 @@ var rootCmd = &cobra.Command{
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(install.ExitCode(err))
 	}
 }
 
@@ -44,4 +44,6 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&options.Upgrade, "upgrade", false, "Indicates that the install is being performed by an upgrade")
 	rootCmd.PersistentFlags().BoolVar(&options.Force, "force", false, "Indicates that the install should forcefully format the partition")
 	rootCmd.PersistentFlags().BoolVar(&options.Zero, "zero", false, "Indicates that the install should write zeros to the disk before installing")
+	rootCmd.PersistentFlags().StringVar(&options.WipeMode, "wipe-mode", "", "The method used to wipe the disk if zero is set (quick, discard or zero)")
+	rootCmd.PersistentFlags().BoolVar(&options.SkipBootDeviceCheck, "skip-boot-device-check", false, "Allows partitioning the disk the running system uses")
 }
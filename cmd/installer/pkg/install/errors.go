@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Installation error classes.
+//
+// Installer errors wrap one of the classes, so that the caller can tell the errors which are worth
+// retrying from the ones which fail on every attempt.
+var (
+	// ErrConfigInvalid denotes that the installation options or the machine configuration are invalid.
+	ErrConfigInvalid = errors.New("invalid installation configuration")
+	// ErrDeviceNotEmpty denotes that the install device already contains Talos filesystems.
+	ErrDeviceNotEmpty = errors.New("install device is not empty")
+	// ErrDeviceInUse denotes that the install device is mounted by the running system.
+	ErrDeviceInUse = errors.New("install device is in use")
+	// ErrInsufficientSpace denotes that there is not enough space to complete the installation.
+	ErrInsufficientSpace = errors.New("insufficient space")
+	// ErrDownloadFailed denotes that the installer image can't be downloaded.
+	ErrDownloadFailed = errors.New("installer image download failed")
+)
+
+// DownloadError wraps the error of the installer image download.
+//
+// It matches ErrDownloadFailed, while the cause of the failure is still available to errors.Is and errors.As.
+type DownloadError struct {
+	Err error
+}
+
+// Error implements error interface.
+func (e *DownloadError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrDownloadFailed, e.Err)
+}
+
+// Unwrap returns the cause of the failure.
+func (e *DownloadError) Unwrap() error {
+	return e.Err
+}
+
+// Is implements errors.Is interface.
+func (e *DownloadError) Is(target error) bool {
+	return target == ErrDownloadFailed //nolint:errorlint
+}
+
+// exitCodes maps the error classes to the installer exit codes.
+//
+// Exit code 1 is reserved for the errors which don't belong to any class.
+var exitCodes = []struct {
+	err  error
+	code int
+}{
+	{ErrConfigInvalid, 2},
+	{ErrDeviceNotEmpty, 3},
+	{ErrDeviceInUse, 4},
+	{ErrInsufficientSpace, 5},
+	{ErrDownloadFailed, 6},
+}
+
+// ExitCode returns the installer exit code for the error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	for _, c := range exitCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+
+	return 1
+}
+
+// ErrorFromExitCode returns the error class for the installer exit code.
+//
+// It returns nil if the exit code doesn't correspond to any error class.
+func ErrorFromExitCode(code int) error {
+	for _, c := range exitCodes {
+		if c.code == code {
+			return c.err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/install"
+)
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, install.ExitCode(nil))
+	assert.Equal(t, 1, install.ExitCode(errors.New("some error")))
+	assert.Nil(t, install.ErrorFromExitCode(1))
+
+	for _, class := range []error{
+		install.ErrConfigInvalid,
+		install.ErrDeviceNotEmpty,
+		install.ErrDeviceInUse,
+		install.ErrInsufficientSpace,
+		install.ErrDownloadFailed,
+	} {
+		code := install.ExitCode(fmt.Errorf("failed to prepare ephemeral partition: %w", class))
+
+		assert.Greater(t, code, 1)
+		assert.Equal(t, class, install.ErrorFromExitCode(code))
+	}
+}
+
+func TestDownloadError(t *testing.T) {
+	err := fmt.Errorf("error pulling image: %w", &install.DownloadError{Err: context.DeadlineExceeded})
+
+	assert.ErrorIs(t, err, install.ErrDownloadFailed)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 6, install.ExitCode(err))
+	assert.EqualError(t, err, "error pulling image: installer image download failed: context deadline exceeded")
+}
@@ -26,17 +26,22 @@ import (
 
 // Options represents the set of options available for an install.
 type Options struct {
-	ConfigSource      string
-	Disk              string
-	Platform          string
-	Arch              string
-	Board             string
-	ExtraKernelArgs   []string
-	Bootloader        bool
-	Upgrade           bool
-	Force             bool
-	Zero              bool
-	LegacyBIOSSupport bool
+	ConfigSource        string
+	Disk                string
+	Platform            string
+	Arch                string
+	Board               string
+	ExtraKernelArgs     []string
+	Bootloader          bool
+	Upgrade             bool
+	Force               bool
+	Zero                bool
+	WipeMode            string
+	LegacyBIOSSupport   bool
+	SkipBootDeviceCheck bool
+
+	// Progress receives the installation progress events, optional.
+	Progress ProgressFunc
 }
 
 // Install installs Talos.
@@ -230,6 +235,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		}
 	}
 
+	i.options.Progress.report(PhaseMounting, "", 0, 0)
+
 	if err = mount.Mount(mountpoints); err != nil {
 		return err
 	}
@@ -246,7 +253,7 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 	for _, targets := range i.manifest.Targets {
 		for _, target := range targets {
 			// Handle the download and extraction of assets.
-			if err = target.Save(); err != nil {
+			if err = target.Save(i.options.Progress); err != nil {
 				return err
 			}
 		}
@@ -258,6 +265,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		return nil
 	}
 
+	i.options.Progress.report(PhaseBootloader, i.options.Disk, 0, 0)
+
 	i.cmdline.Append("initrd", filepath.Join("/", i.Next, constants.InitramfsAsset))
 
 	grubcfg := &grub.Cfg{
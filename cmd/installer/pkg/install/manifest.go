@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -32,6 +33,7 @@ type Manifest struct {
 	Devices           map[string]Device
 	Targets           map[string][]*Target
 	LegacyBIOSSupport bool
+	Progress          ProgressFunc
 }
 
 // Device represents device options.
@@ -40,8 +42,10 @@ type Device struct {
 
 	ResetPartitionTable bool
 	Zero                bool
+	WipeMode            string
 
 	SkipOverlayMountsCheck bool
+	SkipBootDeviceCheck    bool
 }
 
 // NewManifest initializes and returns a Manifest.
@@ -56,6 +60,7 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		Devices:           map[string]Device{},
 		Targets:           map[string][]*Target{},
 		LegacyBIOSSupport: opts.LegacyBIOSSupport,
+		Progress:          opts.Progress,
 	}
 
 	if opts.Board != constants.BoardNone {
@@ -75,7 +80,7 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 	}
 
 	if !opts.Force && opts.Zero {
-		return nil, fmt.Errorf("zero option can't be used without force")
+		return nil, fmt.Errorf("zero option can't be used without force: %w", ErrConfigInvalid)
 	}
 
 	if !opts.Force && !bootPartitionFound {
@@ -104,8 +109,10 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 
 		ResetPartitionTable: opts.Force,
 		Zero:                opts.Zero,
+		WipeMode:            opts.WipeMode,
 
 		SkipOverlayMountsCheck: skipOverlayMountsCheck,
+		SkipBootDeviceCheck:    opts.SkipBootDeviceCheck || sequence == runtime.SequenceUpgrade,
 	}
 
 	// Initialize any slices we need. Note that a boot partition is not
@@ -182,8 +189,26 @@ func (m *Manifest) Execute() (err error) {
 
 // checkMounts verifies that no active mounts in any mount namespace exist for the device.
 //
-//nolint:gocyclo
+// If the partition table is going to be reset, mounts of the device partitions are checked as well,
+// as partitioning the device would corrupt the filesystems in use.
+//
+//nolint:gocyclo,cyclop
 func (m *Manifest) checkMounts(device Device) error {
+	devices := map[string]struct{}{
+		device.Device: {},
+	}
+
+	if device.ResetPartitionTable && !device.SkipBootDeviceCheck {
+		partitions, err := devicePartitions(device.Device, "/sys/block")
+		if err != nil {
+			return err
+		}
+
+		for _, part := range partitions {
+			devices[part] = struct{}{}
+		}
+	}
+
 	matches, err := filepath.Glob("/proc/*/mountinfo")
 	if err != nil {
 		return err
@@ -221,14 +246,14 @@ func (m *Manifest) checkMounts(device Device) error {
 						parts := strings.SplitN(option, "=", 2)
 						if len(parts) == 2 {
 							if strings.HasPrefix(parts[1], "/var/") {
-								return fmt.Errorf("found overlay mount in %q: %s", path, scanner.Text())
+								return fmt.Errorf("found overlay mount in %q: %s: %w", path, scanner.Text(), ErrDeviceInUse)
 							}
 						}
 					}
 				}
 
-				if fields[len(fields)-2] == device.Device {
-					return fmt.Errorf("found active mount in %q for %q: %s", path, device.Device, scanner.Text())
+				if _, ok := devices[fields[len(fields)-2]]; ok {
+					return fmt.Errorf("found active mount in %q for %q: %s: %w", path, device.Device, scanner.Text(), ErrDeviceInUse)
 				}
 			}
 
@@ -241,6 +266,28 @@ func (m *Manifest) checkMounts(device Device) error {
 	return nil
 }
 
+// devicePartitions returns the paths of the device partitions found in the sysfs block directory.
+func devicePartitions(devname, sysBlockPath string) ([]string, error) {
+	entries, err := ioutil.ReadDir(filepath.Join(sysBlockPath, filepath.Base(devname)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var partitions []string
+
+	for _, entry := range entries {
+		if _, err = os.Stat(filepath.Join(sysBlockPath, filepath.Base(devname), entry.Name(), "partition")); err == nil {
+			partitions = append(partitions, filepath.Join(filepath.Dir(devname), entry.Name()))
+		}
+	}
+
+	return partitions, nil
+}
+
 //nolint:gocyclo,cyclop
 func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error) {
 	if err = m.checkMounts(device); err != nil {
@@ -252,7 +299,7 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 	}
 
 	if device.Zero {
-		if err = m.zeroDevice(device); err != nil {
+		if err = m.wipeDevice(device); err != nil {
 			return err
 		}
 	}
@@ -363,7 +410,15 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 		return err
 	}
 
+	header := pt.Header()
+
+	if err = resolveRelativeSizes((header.LastUsableLBA-header.FirstUsableLBA+1)*uint64(header.LogicalBlockSize), targets); err != nil {
+		return fmt.Errorf("failed to resolve partition sizes on %q: %w", device.Device, err)
+	}
+
 	for i, target := range targets {
+		m.Progress.report(PhasePartitioning, device.Device, int64(i), int64(len(targets)))
+
 		if err = target.Partition(pt, i, bd); err != nil {
 			return fmt.Errorf("failed to partition device: %w", err)
 		}
@@ -373,9 +428,11 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 		return err
 	}
 
-	for _, target := range targets {
+	for i, target := range targets {
 		target := target
 
+		m.Progress.report(PhaseFormatting, device.Device, int64(i), int64(len(targets)))
+
 		err = retry.Constant(time.Minute, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
 			e := target.Format()
 			if e != nil {
@@ -489,6 +546,10 @@ func (m *Manifest) preserveContents(device Device, targets []*Target) (err error
 
 func (m *Manifest) restoreContents(targets []*Target) error {
 	for _, target := range targets {
+		if target.Contents != nil {
+			m.Progress.report(PhaseRestoring, target.Label, 0, 0)
+		}
+
 		if err := target.RestoreContents(); err != nil {
 			return fmt.Errorf("error restoring contents for %q: %w", target.Label, err)
 		}
@@ -516,29 +577,6 @@ func (m *Manifest) SystemMountpoints(opts ...mount.Option) (*mount.Points, error
 	return mountpoints, nil
 }
 
-// zeroDevice fills the device with zeroes.
-func (m *Manifest) zeroDevice(device Device) (err error) {
-	var bd *blockdevice.BlockDevice
-
-	log.Printf("wiping %q", device.Device)
-
-	if bd, err = blockdevice.Open(device.Device, blockdevice.WithExclusiveLock(true)); err != nil {
-		return err
-	}
-
-	defer bd.Close() //nolint:errcheck
-
-	var method string
-
-	if method, err = bd.Wipe(); err != nil {
-		return err
-	}
-
-	log.Printf("wiped %q with %q", device.Device, method)
-
-	return bd.Close()
-}
-
 func shouldSkipOverlayMountsCheck(sequence runtime.Sequence) (bool, error) {
 	var skipOverlayMountsCheck bool
 
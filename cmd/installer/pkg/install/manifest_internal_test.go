@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevicePartitions(t *testing.T) {
+	sysBlock := t.TempDir()
+
+	for _, path := range []string{
+		"sda/sda1/partition",
+		"sda/sda2/partition",
+		"sda/queue/partition_unused",
+		"nvme0n1/nvme0n1p1/partition",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(sysBlock, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(sysBlock, path), nil, 0o644))
+	}
+
+	for _, tt := range []struct {
+		device     string
+		partitions []string
+	}{
+		{
+			device:     "/dev/sda",
+			partitions: []string{"/dev/sda1", "/dev/sda2"},
+		},
+		{
+			device:     "/dev/nvme0n1",
+			partitions: []string{"/dev/nvme0n1p1"},
+		},
+		{
+			device: "/dev/sdb",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.device, func(t *testing.T) {
+			partitions, err := devicePartitions(tt.device, sysBlock)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.partitions, partitions)
+		})
+	}
+}
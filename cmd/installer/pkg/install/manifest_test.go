@@ -350,7 +350,7 @@ func (suite *manifestSuite) TestTargetInstall() {
 		},
 	}
 
-	suite.Require().NoError(target.Save())
+	suite.Require().NoError(target.Save(nil))
 
 	for _, expectedFile := range target.Assets {
 		// Verify copied file is at the appropriate location.
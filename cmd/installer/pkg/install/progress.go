@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"io"
+)
+
+// Phase is the phase of the installation.
+type Phase string
+
+// Installation phases.
+const (
+	PhaseWiping       Phase = "wiping"
+	PhasePartitioning Phase = "partitioning"
+	PhaseFormatting   Phase = "formatting"
+	PhaseRestoring    Phase = "restoring"
+	PhaseMounting     Phase = "mounting"
+	PhaseCopying      Phase = "copying"
+	PhaseBootloader   Phase = "bootloader"
+)
+
+// ProgressEvent reports the progress of the installation phase.
+//
+// Done and Total are counted in the phase units: partitions for partitioning and formatting,
+// bytes for wiping and copying. Total is zero if the phase progress is not measured.
+type ProgressEvent struct {
+	Phase  Phase
+	Target string
+	Done   int64
+	Total  int64
+}
+
+// String implements fmt.Stringer.
+func (event ProgressEvent) String() string {
+	s := string(event.Phase)
+
+	if event.Target != "" {
+		s += " " + event.Target
+	}
+
+	if event.Total > 0 {
+		s += fmt.Sprintf(" %d/%d (%d%%)", event.Done, event.Total, event.Done*100/event.Total)
+	}
+
+	return s
+}
+
+// ProgressFunc receives the installation progress events.
+type ProgressFunc func(ProgressEvent)
+
+func (f ProgressFunc) report(phase Phase, target string, done, total int64) {
+	if f == nil {
+		return
+	}
+
+	f(ProgressEvent{
+		Phase:  phase,
+		Target: target,
+		Done:   done,
+		Total:  total,
+	})
+}
+
+// progressStep is the share of the total (in percent) reported by progressWriter at once.
+const progressStep = 10
+
+// progressWriter reports the number of bytes written in progressStep increments.
+type progressWriter struct {
+	w io.Writer
+
+	progress ProgressFunc
+	phase    Phase
+	target   string
+
+	done, total, reported int64
+}
+
+// Write implements io.Writer.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+
+	w.done += int64(n)
+
+	if w.done != w.reported && (w.done == w.total || w.total > 0 && (w.done-w.reported)*100 >= w.total*progressStep) {
+		w.reported = w.done
+
+		w.progress.report(w.phase, w.target, w.done, w.total)
+	}
+
+	return n, err
+}
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressWriter(t *testing.T) {
+	var events []ProgressEvent
+
+	var buf bytes.Buffer
+
+	w := &progressWriter{
+		w: &buf,
+		progress: func(event ProgressEvent) {
+			events = append(events, event)
+		},
+		phase:  PhaseCopying,
+		target: "vmlinuz",
+		total:  1000,
+	}
+
+	for i := 0; i < 40; i++ {
+		_, err := io.CopyN(w, bytes.NewReader(make([]byte, 25)), 25)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, events, 10)
+
+	for i, event := range events {
+		assert.Equal(t, int64(100*(i+1)), event.Done)
+		assert.Equal(t, int64(1000), event.Total)
+	}
+
+	assert.Equal(t, "copying vmlinuz 1000/1000 (100%)", events[9].String())
+	assert.Equal(t, 1000, buf.Len())
+
+	// nil progress func is ignored
+	w = &progressWriter{w: &buf, total: 10}
+
+	_, err := w.Write(make([]byte, 10))
+	require.NoError(t, err)
+}
+
+func TestWriteZeroes(t *testing.T) {
+	var events []ProgressEvent
+
+	var buf bytes.Buffer
+
+	size := int64(zeroChunkSize*10 + 5)
+
+	require.NoError(t, writeZeroes(&buf, size, func(event ProgressEvent) {
+		events = append(events, event)
+	}, "/dev/sda"))
+
+	assert.Equal(t, size, int64(buf.Len()))
+	assert.Equal(t, bytes.Repeat([]byte{0}, int(size)), buf.Bytes())
+
+	require.NotEmpty(t, events)
+	assert.Equal(t, "wiping /dev/sda 41943045/41943045 (100%)", events[len(events)-1].String())
+}
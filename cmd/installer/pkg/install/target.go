@@ -5,6 +5,7 @@
 package install
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -50,6 +51,13 @@ type Target struct {
 	// Skipped partitions should exist on the disk by the time manifest execution starts.
 	Skip bool
 
+	// RelativeSize is the percentage of the disk size the partition occupies (or of the space
+	// left after the rest of the partitions if RelativeToFree is set).
+	//
+	// If set, Size is resolved when the device is partitioned.
+	RelativeSize   float64
+	RelativeToFree bool
+
 	// set during execution
 	PartitionName string
 	Contents      *bytes.Buffer
@@ -221,6 +229,66 @@ func (t *Target) Partition(pt *gpt.GPT, pos int, bd *blockdevice.BlockDevice) (e
 	return nil
 }
 
+// relativeSizeAlignment is the granularity of the partition sizes resolved from the relative sizes.
+//
+// Space for the alignment of the partitions is reserved as well.
+const relativeSizeAlignment = 1024 * 1024
+
+// resolveRelativeSizes sets the size of the targets with the relative size.
+//
+// Percentages are applied to the space available for the partitions on the device,
+// percentages of the free space are applied to the space left after all other partitions are allocated.
+func resolveRelativeSizes(available uint64, targets []*Target) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	reserved := uint64(len(targets)) * relativeSizeAlignment
+
+	if available > reserved {
+		available -= reserved
+	} else {
+		available = 0
+	}
+
+	var allocated uint64
+
+	for _, t := range targets {
+		if t.RelativeToFree {
+			continue
+		}
+
+		if t.RelativeSize > 0 {
+			t.Size = alignRelativeSize(available, t.RelativeSize)
+		}
+
+		allocated += t.Size
+	}
+
+	if allocated > available {
+		return fmt.Errorf("partitions require %s, but only %s is available", humanize.Bytes(allocated), humanize.Bytes(available))
+	}
+
+	free := available - allocated
+
+	for _, t := range targets {
+		if t.RelativeToFree {
+			t.Size = alignRelativeSize(free, t.RelativeSize)
+		}
+	}
+
+	if last := targets[len(targets)-1]; last.RelativeSize >= 100 {
+		// the last partition occupies the rest of the disk
+		last.Size = 0
+	}
+
+	return nil
+}
+
+func alignRelativeSize(base uint64, percent float64) uint64 {
+	return uint64(float64(base)*percent/100) / relativeSizeAlignment * relativeSizeAlignment
+}
+
 // Format creates a filesystem on the device/partition.
 func (t *Target) Format() error {
 	if t.Skip {
@@ -231,7 +299,9 @@ func (t *Target) Format() error {
 }
 
 // Save copies the assets to the bootloader partition.
-func (t *Target) Save() (err error) {
+//
+// Copy progress is reported to the progress func, which might be nil.
+func (t *Target) Save(progress ProgressFunc) (err error) {
 	for _, asset := range t.Assets {
 		asset := asset
 
@@ -260,7 +330,21 @@ func (t *Target) Save() (err error) {
 
 			log.Printf("copying %s to %s\n", sourceFile.Name(), destFile.Name())
 
-			if _, err = io.Copy(destFile, sourceFile); err != nil {
+			var st os.FileInfo
+
+			if st, err = sourceFile.Stat(); err != nil {
+				return err
+			}
+
+			w := &progressWriter{
+				w:        destFile,
+				progress: progress,
+				phase:    PhaseCopying,
+				target:   asset.Destination,
+				total:    st.Size(),
+			}
+
+			if _, err = io.Copy(w, sourceFile); err != nil {
 				log.Printf("failed to copy %s to %s\n", sourceFile.Name(), destFile.Name())
 
 				return err
@@ -410,7 +494,65 @@ func (t *Target) restoreRawContents() error {
 }
 
 func (t *Target) restoreFilesystemContents() error {
+	size, err := contentsSize(t.Contents.Bytes())
+	if err != nil {
+		return fmt.Errorf("error reading preserved contents of %q: %w", t.Label, err)
+	}
+
 	return withTemporaryMounted(t.PartitionName, 0, t.FileSystemType, t.Label, func(mountPath string) error {
+		if err := checkFreeSpace(mountPath, size); err != nil {
+			return fmt.Errorf("error restoring contents of %q: %w", t.Label, err)
+		}
+
 		return archiver.UntarGz(context.TODO(), t.Contents, mountPath)
 	})
 }
+
+// freeSpaceMargin accounts for the filesystem overhead (block rounding, inodes, directories)
+// when checking that the extracted contents fit the filesystem.
+const freeSpaceMargin = 1.1
+
+// contentsSize returns the total size of the files in the .tar.gz archive.
+func contentsSize(data []byte) (uint64, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+
+	tr := tar.NewReader(zr)
+
+	var size uint64
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return size, nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		if hdr.Typeflag == tar.TypeReg {
+			size += uint64(hdr.Size)
+		}
+	}
+}
+
+// checkFreeSpace verifies that the filesystem mounted at path has enough space available to store size bytes.
+func checkFreeSpace(path string, size uint64) error {
+	var st unix.Statfs_t
+
+	if err := unix.Statfs(path, &st); err != nil {
+		return fmt.Errorf("error checking free space of %q: %w", path, err)
+	}
+
+	need := uint64(float64(size) * freeSpaceMargin)
+	have := st.Bavail * uint64(st.Bsize)
+
+	if need > have {
+		return fmt.Errorf("not enough free space on %q: need %s, have %s: %w", path, humanize.Bytes(need), humanize.Bytes(have), ErrInsufficientSpace)
+	}
+
+	return nil
+}
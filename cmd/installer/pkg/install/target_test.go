@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/partition"
+)
+
+func TestContentsSize(t *testing.T) {
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o755}))
+
+	for _, contents := range []string{"hello", "world!"} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/" + contents, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(contents))}))
+
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	size, err := contentsSize(buf.Bytes())
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+
+	_, err = contentsSize([]byte("garbage"))
+	assert.Error(t, err)
+}
+
+func TestCheckFreeSpace(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.NoError(t, checkFreeSpace(dir, 0))
+
+	err := checkFreeSpace(dir, math.MaxUint64/2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not enough free space")
+	assert.ErrorIs(t, err, ErrInsufficientSpace)
+}
+
+func TestResolveRelativeSizes(t *testing.T) {
+	const (
+		MiB = 1024 * 1024
+		GiB = 1024 * MiB
+	)
+
+	newTarget := func(size uint64, relative float64, free bool) *Target {
+		return &Target{
+			FormatOptions:  &partition.FormatOptions{Size: size},
+			RelativeSize:   relative,
+			RelativeToFree: free,
+		}
+	}
+
+	targets := []*Target{
+		newTarget(10*GiB, 0, false),
+		newTarget(0, 25, false),
+		newTarget(0, 50, true),
+		newTarget(20*GiB, 0, false),
+		newTarget(0, 100, true),
+	}
+
+	require.NoError(t, resolveRelativeSizes(100*GiB+5*MiB, targets))
+
+	assert.EqualValues(t, 10*GiB, targets[0].Size)
+	assert.EqualValues(t, 25*GiB, targets[1].Size)
+	// half of the space left after the fixed and percentage partitions: (100 - 10 - 25 - 20) / 2
+	assert.EqualValues(t, 22*GiB+512*MiB, targets[2].Size)
+	assert.EqualValues(t, 20*GiB, targets[3].Size)
+	// last partition occupies the rest of the disk
+	assert.EqualValues(t, 0, targets[4].Size)
+
+	var total uint64
+
+	for _, target := range targets {
+		total += target.Size
+	}
+
+	assert.LessOrEqual(t, total, uint64(100*GiB))
+
+	targets = []*Target{
+		newTarget(0, 50, true),
+		newTarget(0, 60, false),
+		newTarget(10*GiB, 0, false),
+	}
+
+	require.NoError(t, resolveRelativeSizes(100*GiB+3*MiB, targets))
+
+	assert.EqualValues(t, 15*GiB, targets[0].Size)
+	assert.EqualValues(t, 60*GiB, targets[1].Size)
+	assert.EqualValues(t, 10*GiB, targets[2].Size)
+
+	targets = []*Target{
+		newTarget(50*GiB, 0, false),
+		newTarget(0, 60, false),
+	}
+
+	err := resolveRelativeSizes(100*GiB+2*MiB, targets)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "partitions require")
+}
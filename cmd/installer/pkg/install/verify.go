@@ -5,7 +5,6 @@
 package install
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice"
@@ -17,7 +16,7 @@ import (
 // VerifyEphemeralPartition verifies the supplied data device options.
 func VerifyEphemeralPartition(opts *Options) (err error) {
 	if opts.Disk == "" {
-		return errors.New("missing disk")
+		return fmt.Errorf("missing disk: %w", ErrConfigInvalid)
 	}
 
 	if opts.Force {
@@ -75,7 +74,7 @@ func VerifyDiskAvailability(devpath, label string) (err error) {
 	}
 
 	if fsType != filesystem.Unknown {
-		return fmt.Errorf("target install device %s is not empty, found existing %s file system", label, fsType)
+		return fmt.Errorf("found existing %s file system with label %s: %w", fsType, label, ErrDeviceNotEmpty)
 	}
 
 	return nil
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"unsafe"
+
+	"github.com/talos-systems/go-blockdevice/blockdevice"
+	"golang.org/x/sys/unix"
+)
+
+// Wipe modes.
+//
+// If the wipe mode is not set, the device is zeroed out using the fastest available method
+// (secure discard, discard with zeroes or zeroing out).
+const (
+	// WipeModeQuick discards the whole device ignoring the errors, and zeroes out the first 1 MiB.
+	WipeModeQuick = "quick"
+	// WipeModeDiscard discards (TRIMs) the whole device, it fails if the device doesn't support discard.
+	WipeModeDiscard = "discard"
+	// WipeModeZero writes zeroes across the whole device.
+	WipeModeZero = "zero"
+)
+
+// zeroChunkSize is the size of the buffer used to write zeroes.
+const zeroChunkSize = 4 * 1024 * 1024
+
+// wipeDevice wipes the device using the device wipe mode.
+func (m *Manifest) wipeDevice(device Device) (err error) {
+	var bd *blockdevice.BlockDevice
+
+	log.Printf("wiping %q", device.Device)
+
+	if bd, err = blockdevice.Open(device.Device, blockdevice.WithExclusiveLock(true)); err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	method := device.WipeMode
+
+	switch device.WipeMode {
+	case "":
+		method, err = bd.Wipe()
+	case WipeModeQuick:
+		err = bd.FastWipe()
+	case WipeModeDiscard:
+		err = discard(bd)
+	case WipeModeZero:
+		var size uint64
+
+		if size, err = bd.Size(); err != nil {
+			return err
+		}
+
+		if err = writeZeroes(bd.Device(), int64(size), m.Progress, device.Device); err == nil {
+			err = bd.Device().Sync()
+		}
+	default:
+		return fmt.Errorf("unsupported wipe mode %q: %w", device.WipeMode, ErrConfigInvalid)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("wiped %q with %q", device.Device, method)
+
+	return bd.Close()
+}
+
+// discard issues BLKDISCARD for the whole device.
+func discard(bd *blockdevice.BlockDevice) error {
+	size, err := bd.Size()
+	if err != nil {
+		return err
+	}
+
+	r := [2]uint64{0, size}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, bd.Device().Fd(), blockdevice.BLKDISCARD, uintptr(unsafe.Pointer(&r[0]))); errno != 0 {
+		return fmt.Errorf("error discarding device contents: %w", errno)
+	}
+
+	return nil
+}
+
+// writeZeroes writes size zero bytes to w reporting the progress.
+func writeZeroes(w io.Writer, size int64, progress ProgressFunc, target string) error {
+	zeroes := make([]byte, zeroChunkSize)
+
+	pw := &progressWriter{
+		w:        w,
+		progress: progress,
+		phase:    PhaseWiping,
+		target:   target,
+		total:    size,
+	}
+
+	for pw.done < size {
+		chunk := zeroes
+
+		if size-pw.done < int64(len(chunk)) {
+			chunk = chunk[:size-pw.done]
+		}
+
+		if _, err := pw.Write(chunk); err != nil {
+			return fmt.Errorf("error writing zeroes: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/talos-systems/talos/pkg/provision"
 	"github.com/talos-systems/talos/pkg/provision/access"
 	"github.com/talos-systems/talos/pkg/provision/providers"
+	"github.com/talos-systems/talos/pkg/release"
 	"github.com/talos-systems/talos/pkg/version"
 )
 
@@ -93,6 +94,8 @@ var (
 	cniConfDir                string
 	cniCacheDir               string
 	cniBundleURL              string
+	releaseIndexURL           string
+	releaseVersion            string
 	ports                     string
 	dockerHostIP              string
 	withInitNode              bool
@@ -109,6 +112,11 @@ var (
 	configPatchWorker         string
 	configPatchJoin           string
 	badRTC                    bool
+	lbDialTimeout             time.Duration
+	lbKeepAlivePeriod         time.Duration
+	lbTCPUserTimeout          time.Duration
+	lbUpstreamsDNSName        string
+	lbUpstreamsDNSRefresh     time.Duration
 )
 
 // createCmd represents the cluster up command.
@@ -136,6 +144,10 @@ func create(ctx context.Context) (err error) {
 		return fmt.Errorf("number of masters can't be less than 1")
 	}
 
+	if lbUpstreamsDNSRefresh <= 0 {
+		return fmt.Errorf("--loadbalancer-upstreams-dns-refresh should be positive")
+	}
+
 	nanoCPUs, err := parseCPUShare()
 	if err != nil {
 		return fmt.Errorf("error parsing --cpus: %s", err)
@@ -219,6 +231,12 @@ func create(ctx context.Context) (err error) {
 		}
 	}
 
+	if releaseIndexURL != "" {
+		if err = resolveReleaseArtifacts(ctx); err != nil {
+			return err
+		}
+	}
+
 	provisioner, err := providers.Factory(ctx, provisionerName)
 	if err != nil {
 		return err
@@ -243,6 +261,14 @@ func create(ctx context.Context) (err error) {
 
 				BundleURL: cniBundleURL,
 			},
+			LoadBalancer: provision.LoadBalancerConfig{
+				DialTimeout:     lbDialTimeout,
+				KeepAlivePeriod: lbKeepAlivePeriod,
+				TCPUserTimeout:  lbTCPUserTimeout,
+
+				UpstreamsDNSName:    lbUpstreamsDNSName,
+				UpstreamsDNSRefresh: lbUpstreamsDNSRefresh,
+			},
 		},
 
 		Image:         nodeImage,
@@ -753,6 +779,34 @@ func getDisks() ([]*provision.Disk, error) {
 	return disks, nil
 }
 
+// resolveReleaseArtifacts looks up the CNI bundle and the installer image of the requested release in the release index.
+func resolveReleaseArtifacts(ctx context.Context) error {
+	index, err := release.Fetch(ctx, releaseIndexURL)
+	if err != nil {
+		return err
+	}
+
+	rel, err := index.Resolve(releaseVersion)
+	if err != nil {
+		return err
+	}
+
+	artifact, err := rel.Artifact(fmt.Sprintf("talosctl-cni-bundle-%s.tar.gz", targetArch))
+	if err != nil {
+		return err
+	}
+
+	cniBundleURL = artifact.GetterURL()
+
+	if rel.InstallerImage != "" {
+		nodeInstallImage = rel.InstallerImage
+	}
+
+	fmt.Printf("using artifacts of release %s\n", rel.Version)
+
+	return nil
+}
+
 func trimVersion(version string) string {
 	// remove anything extra after semantic version core, `v0.3.2-1-abcd` -> `v0.3.2`
 	return regexp.MustCompile(`(-\d+(-g[0-9a-f]+)?(-dirty)?)$`).ReplaceAllString(version, "")
@@ -801,6 +855,8 @@ func init() {
 	createCmd.Flags().StringVar(&cniCacheDir, "cni-cache-dir", filepath.Join(defaultCNIDir, "cache"), "CNI cache directory path (VM only)")
 	createCmd.Flags().StringVar(&cniBundleURL, "cni-bundle-url", fmt.Sprintf("https://github.com/talos-systems/talos/releases/download/%s/talosctl-cni-bundle-%s.tar.gz",
 		trimVersion(version.Tag), constants.ArchVariable), "URL to download CNI bundle from (VM only)")
+	createCmd.Flags().StringVar(&releaseIndexURL, "release-index", "", "URL of the release index to look up the CNI bundle and the installer image in, overrides --cni-bundle-url and --install-image")
+	createCmd.Flags().StringVar(&releaseVersion, "release-version", release.Latest, "release version to look up in the release index")
 	createCmd.Flags().StringVarP(&ports,
 		"exposed-ports",
 		"p",
@@ -822,6 +878,12 @@ func init() {
 	createCmd.Flags().StringVar(&configPatchControlPlane, "config-patch-control-plane", "", "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
 	createCmd.Flags().StringVar(&configPatchWorker, "config-patch-worker", "", "patch generated machineconfigs (applied to 'worker' type)")
 	createCmd.Flags().BoolVar(&badRTC, "bad-rtc", false, "launch VM with bad RTC state (QEMU only)")
+	createCmd.Flags().DurationVar(&lbDialTimeout, "loadbalancer-dial-timeout", 10*time.Second, "timeout to establish the connection to the control plane node (VM only)")
+	createCmd.Flags().DurationVar(&lbKeepAlivePeriod, "loadbalancer-keep-alive-period", time.Minute, "period between TCP keep alives on the load balancer connections (VM only)")
+	createCmd.Flags().DurationVar(&lbTCPUserTimeout, "loadbalancer-tcp-user-timeout", 0,
+		"close the load balancer connections if the sent data is not acknowledged within the timeout, disabled if zero (VM only)")
+	createCmd.Flags().StringVar(&lbUpstreamsDNSName, "loadbalancer-upstreams-dns-name", "", "DNS name resolved to additional load balancer upstreams (VM only)")
+	createCmd.Flags().DurationVar(&lbUpstreamsDNSRefresh, "loadbalancer-upstreams-dns-refresh", 30*time.Second, "interval to resolve the load balancer upstreams DNS name (VM only)")
 
 	// remove in 0.13: https://github.com/talos-systems/talos/issues/3910
 	createCmd.Flags().StringVar(&configPatchJoin, "config-patch-join", "", "")
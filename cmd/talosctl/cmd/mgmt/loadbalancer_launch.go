@@ -5,7 +5,12 @@
 package mgmt
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/talos-systems/go-loadbalancer/loadbalancer"
@@ -14,11 +19,18 @@ import (
 )
 
 var loadbalancerLaunchCmdFlags struct {
-	addr             string
-	upstreams        []string
-	apidOnlyInitNode bool
+	addr                string
+	upstreams           []string
+	upstreamsDNSName    string
+	upstreamsDNSRefresh time.Duration
+	apidOnlyInitNode    bool
+	dialTimeout         time.Duration
+	keepAlivePeriod     time.Duration
+	tcpUserTimeout      time.Duration
 }
 
+var loadbalancerPorts = []int{constants.DefaultControlPlanePort}
+
 // loadbalancerLaunchCmd represents the loadbalancer-launch command.
 var loadbalancerLaunchCmd = &cobra.Command{
 	Use:    "loadbalancer-launch",
@@ -27,25 +39,121 @@ var loadbalancerLaunchCmd = &cobra.Command{
 	Args:   cobra.NoArgs,
 	Hidden: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var lb loadbalancer.TCP
+		if loadbalancerLaunchCmdFlags.upstreamsDNSRefresh <= 0 {
+			return fmt.Errorf("--loadbalancer-upstreams-dns-refresh should be positive")
+		}
+
+		lb := loadbalancer.TCP{
+			DialTimeout:     loadbalancerLaunchCmdFlags.dialTimeout,
+			KeepAlivePeriod: loadbalancerLaunchCmdFlags.keepAlivePeriod,
+			TCPUserTimeout:  loadbalancerLaunchCmdFlags.tcpUserTimeout,
+		}
+
+		upstreams := loadbalancerLaunchCmdFlags.upstreams
 
-		for _, port := range []int{constants.DefaultControlPlanePort} {
-			upstreams := make([]string, len(loadbalancerLaunchCmdFlags.upstreams))
-			for i := range upstreams {
-				upstreams[i] = fmt.Sprintf("%s:%d", loadbalancerLaunchCmdFlags.upstreams[i], port)
+		if loadbalancerLaunchCmdFlags.upstreamsDNSName != "" {
+			resolved, err := net.DefaultResolver.LookupHost(context.Background(), loadbalancerLaunchCmdFlags.upstreamsDNSName)
+			if err != nil {
+				// DNS might be not available yet, upstreams are refreshed in the background
+				log.Printf("error resolving upstreams %q: %s", loadbalancerLaunchCmdFlags.upstreamsDNSName, err)
 			}
 
-			if err := lb.AddRoute(fmt.Sprintf("%s:%d", loadbalancerLaunchCmdFlags.addr, port), upstreams); err != nil {
+			upstreams = mergeUpstreams(upstreams, resolved)
+		}
+
+		for _, port := range loadbalancerPorts {
+			if err := lb.AddRoute(joinHostPort(loadbalancerLaunchCmdFlags.addr, port), upstreamAddrs(upstreams, port)); err != nil {
 				return err
 			}
 		}
 
+		if loadbalancerLaunchCmdFlags.upstreamsDNSName != "" {
+			go refreshUpstreams(context.Background(), &lb, loadbalancerLaunchCmdFlags.upstreamsDNSName, loadbalancerLaunchCmdFlags.upstreamsDNSRefresh)
+		}
+
 		return lb.Run()
 	},
 }
 
+// refreshUpstreams periodically resolves the DNS name and updates the upstreams.
+//
+// Static upstreams are always kept, upstreams are not changed if the DNS name fails to resolve.
+func refreshUpstreams(ctx context.Context, lb *loadbalancer.TCP, name string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resolved, err := net.DefaultResolver.LookupHost(ctx, name)
+		if err != nil {
+			log.Printf("error resolving upstreams %q: %s", name, err)
+
+			continue
+		}
+
+		upstreams := mergeUpstreams(loadbalancerLaunchCmdFlags.upstreams, resolved)
+
+		for _, port := range loadbalancerPorts {
+			if err = lb.ReconcileRoute(joinHostPort(loadbalancerLaunchCmdFlags.addr, port), upstreamAddrs(upstreams, port)); err != nil {
+				log.Printf("error updating upstreams: %s", err)
+			}
+		}
+	}
+}
+
+// mergeUpstreams appends resolved upstreams to the static ones skipping duplicates.
+func mergeUpstreams(static, resolved []string) []string {
+	upstreams := append([]string(nil), static...)
+
+	seen := make(map[string]struct{}, len(static))
+
+	for _, upstream := range static {
+		seen[upstream] = struct{}{}
+	}
+
+	for _, upstream := range resolved {
+		if _, ok := seen[upstream]; ok {
+			continue
+		}
+
+		seen[upstream] = struct{}{}
+
+		upstreams = append(upstreams, upstream)
+	}
+
+	return upstreams
+}
+
+// upstreamAddrs builds the upstream addresses for the port.
+func upstreamAddrs(upstreams []string, port int) []string {
+	addrs := make([]string, len(upstreams))
+
+	for i := range upstreams {
+		addrs[i] = joinHostPort(upstreams[i], port)
+	}
+
+	return addrs
+}
+
+// joinHostPort handles IPv6 hosts which should be enclosed in square brackets.
+func joinHostPort(host string, port int) string {
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
 func init() {
 	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.addr, "loadbalancer-addr", "localhost", "load balancer listen address (IP or host)")
 	loadbalancerLaunchCmd.Flags().StringSliceVar(&loadbalancerLaunchCmdFlags.upstreams, "loadbalancer-upstreams", []string{}, "load balancer upstreams (nodes to proxy to)")
+	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.upstreamsDNSName, "loadbalancer-upstreams-dns-name", "",
+		"DNS name resolved to additional load balancer upstreams (A/AAAA records)")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.upstreamsDNSRefresh, "loadbalancer-upstreams-dns-refresh", 30*time.Second, "interval to resolve the upstreams DNS name")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.dialTimeout, "dial-timeout", 10*time.Second, "timeout to establish the connection to the upstream")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.keepAlivePeriod, "keep-alive-period", time.Minute, "period between TCP keep alives on the proxied connections")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.tcpUserTimeout, "tcp-user-timeout", 0,
+		"close the proxied connections if the sent data is not acknowledged within the timeout (disabled if zero)")
 	addCommand(loadbalancerLaunchCmd)
 }
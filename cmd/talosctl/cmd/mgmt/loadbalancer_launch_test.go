@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mgmt
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamAddrs(t *testing.T) {
+	addrs := upstreamAddrs([]string{"10.5.0.2", "fd74:616c:a05::2", "node-1"}, 6443)
+
+	assert.Equal(t, []string{"10.5.0.2:6443", "[fd74:616c:a05::2]:6443", "node-1:6443"}, addrs)
+
+	for _, addr := range addrs {
+		_, port, err := net.SplitHostPort(addr)
+		require.NoError(t, err)
+		assert.Equal(t, "6443", port)
+	}
+
+	assert.Equal(t, "[fd74:616c:a05::1]:6443", joinHostPort("fd74:616c:a05::1", 6443))
+}
+
+func TestMergeUpstreams(t *testing.T) {
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3", "10.5.0.4"}, mergeUpstreams([]string{"10.5.0.2", "10.5.0.3"}, []string{"10.5.0.3", "10.5.0.4", "10.5.0.4"}))
+	assert.Equal(t, []string{"10.5.0.2"}, mergeUpstreams([]string{"10.5.0.2"}, nil))
+	assert.Equal(t, []string{"10.5.0.4"}, mergeUpstreams(nil, []string{"10.5.0.4"}))
+}
@@ -9,10 +9,14 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/talos-systems/crypto/x509"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/internal/pkg/tui/installer"
@@ -28,6 +32,7 @@ var applyConfigCmdFlags struct {
 	interactive      bool
 	onReboot         bool
 	immediate        bool
+	dryRun           bool
 }
 
 // applyConfigCmd represents the applyConfiguration command.
@@ -68,6 +73,10 @@ var applyConfigCmd = &cobra.Command{
 			return fmt.Errorf("no filename supplied for configuration")
 		}
 
+		if applyConfigCmdFlags.dryRun && applyConfigCmdFlags.interactive {
+			return fmt.Errorf("--dry-run is not supported in interactive mode")
+		}
+
 		withClient := func(f func(context.Context, *client.Client) error) error {
 			if applyConfigCmdFlags.insecure {
 				ctx := context.Background()
@@ -150,6 +159,10 @@ var applyConfigCmd = &cobra.Command{
 				return install.Run(conn)
 			}
 
+			if applyConfigCmdFlags.dryRun {
+				return applyConfigDryRun(ctx, c, cfgBytes)
+			}
+
 			resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
 				Data:      cfgBytes,
 				OnReboot:  applyConfigCmdFlags.onReboot,
@@ -169,6 +182,38 @@ var applyConfigCmd = &cobra.Command{
 	},
 }
 
+func applyConfigDryRun(ctx context.Context, c *client.Client, cfgBytes []byte) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.ConfigDiff(ctx, cfgBytes, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error comparing configuration: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tPATH\tCHANGE\tREBOOT")
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, change := range msg.Changes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", node, change.Path, strings.ToLower(change.Op.String()), change.RebootRequired)
+		}
+	}
+
+	return w.Flush()
+}
+
 func init() {
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
 	applyConfigCmd.Flags().BoolVarP(&applyConfigCmdFlags.insecure, "insecure", "i", false, "apply the config using the insecure (encrypted with no auth) maintenance service")
@@ -176,6 +221,7 @@ func init() {
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.interactive, "interactive", false, "apply the config using text based interactive mode")
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.onReboot, "on-reboot", false, "apply the config on reboot")
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.immediate, "immediate", false, "apply the config immediately (without a reboot)")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.dryRun, "dry-run", false, "show the changes to the running config and whether they require a reboot, without applying the config")
 
 	// deprecated, to be removed in 0.12: https://github.com/talos-systems/talos/issues/3909
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.onReboot, "no-reboot", false, "apply the config only after the reboot")
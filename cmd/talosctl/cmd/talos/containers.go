@@ -24,6 +24,12 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
+var containersCmdFlags struct {
+	filter        string
+	limit         uint32
+	continueToken string
+}
+
 // containersCmd represents the processes command.
 var containersCmd = &cobra.Command{
 	Use:     "containers",
@@ -48,7 +54,11 @@ var containersCmd = &cobra.Command{
 
 			var remotePeer peer.Peer
 
-			resp, err := c.Containers(ctx, namespace, driver, grpc.Peer(&remotePeer))
+			resp, err := c.ContainersPage(ctx, namespace, driver, client.ContainersOptions{
+				Filter:        containersCmdFlags.filter,
+				Limit:         containersCmdFlags.limit,
+				ContinueToken: containersCmdFlags.continueToken,
+			}, grpc.Peer(&remotePeer))
 			if err != nil {
 				if resp == nil {
 					return fmt.Errorf("error getting container list: %s", err)
@@ -92,11 +102,32 @@ func containerRender(remotePeer *peer.Peer, resp *machineapi.ContainersResponse)
 		}
 	}
 
-	return w.Flush()
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, msg := range resp.Messages {
+		if msg.ContinueToken == "" {
+			continue
+		}
+
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		fmt.Fprintf(os.Stderr, "%s: more containers available, use --continue %q to list them\n", node, msg.ContinueToken)
+	}
+
+	return nil
 }
 
 func init() {
 	containersCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+	containersCmd.Flags().StringVar(&containersCmdFlags.filter, "filter", "", "show only the containers with the ID, pod ID, name or image containing the filter")
+	containersCmd.Flags().Uint32Var(&containersCmdFlags.limit, "limit", 0, "show at most the specified number of containers per node (all if zero)")
+	containersCmd.Flags().StringVar(&containersCmdFlags.continueToken, "continue", "", "continue listing the containers after the token returned with the previous page")
 
 	containersCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	containersCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// cordonCmd represents the cordon command.
+var cordonCmd = &cobra.Command{
+	Use:   "cordon",
+	Short: "Mark the Kubernetes node as unschedulable",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.Cordon(ctx, &machine.CordonRequest{}); err != nil {
+				return fmt.Errorf("error executing cordon: %s", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	addCommand(cordonCmd)
+}
@@ -13,10 +13,15 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
-var dmesgTail bool
+var dmesgCmdFlags struct {
+	tail       bool
+	priority   string
+	facilities []string
+}
 
 // dmesgCmd represents the dmesg command.
 var dmesgCmd = &cobra.Command{
@@ -26,7 +31,12 @@ var dmesgCmd = &cobra.Command{
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			stream, err := c.Dmesg(ctx, follow, dmesgTail)
+			stream, err := c.MachineClient.Dmesg(ctx, &machine.DmesgRequest{
+				Follow:     follow,
+				Tail:       dmesgCmdFlags.tail,
+				Priority:   dmesgCmdFlags.priority,
+				Facilities: dmesgCmdFlags.facilities,
+			})
 			if err != nil {
 				return fmt.Errorf("error getting dmesg: %w", err)
 			}
@@ -65,5 +75,7 @@ var dmesgCmd = &cobra.Command{
 func init() {
 	addCommand(dmesgCmd)
 	dmesgCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the kernel log should be streamed")
-	dmesgCmd.Flags().BoolVarP(&dmesgTail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().BoolVarP(&dmesgCmdFlags.tail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.priority, "priority", "", "show only messages with the specified priority or more severe (emerg, alert, crit, err, warning, notice, info, debug)")
+	dmesgCmd.Flags().StringSliceVar(&dmesgCmdFlags.facilities, "facility", nil, "show only messages from the specified facilities (e.g. kern, daemon)")
 }
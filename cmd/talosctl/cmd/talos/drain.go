@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var drainCmdFlags struct {
+	timeout time.Duration
+	force   bool
+}
+
+// drainCmd represents the drain command.
+var drainCmd = &cobra.Command{
+	Use:   "drain",
+	Short: "Evict the pods from the Kubernetes node",
+	Long: `The node should be cordoned first with talosctl cordon,
+otherwise evicted pods might be scheduled back to the node.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.Drain(ctx, &machine.DrainRequest{
+				Timeout: durationpb.New(drainCmdFlags.timeout),
+				Force:   drainCmdFlags.force,
+			}); err != nil {
+				return fmt.Errorf("error executing drain: %s", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	drainCmd.Flags().DurationVar(&drainCmdFlags.timeout, "timeout", 5*time.Minute, "maximum time to wait for the pods to be evicted")
+	drainCmd.Flags().BoolVar(&drainCmdFlags.force, "force", false, "if true, evict the pods not managed by a controller")
+	addCommand(drainCmd)
+}
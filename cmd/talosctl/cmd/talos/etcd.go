@@ -15,6 +15,7 @@ import (
 	"sync"
 	"text/tabwriter"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
 // etcdCmd represents the etcd command.
@@ -71,6 +73,69 @@ var etcdForfeitLeadershipCmd = &cobra.Command{
 	},
 }
 
+var etcdDefragmentCmd = &cobra.Command{
+	Use:   "defrag",
+	Short: "Defragment etcd database of the node",
+	Long: `Defragmentation blocks the etcd member while it runs, so the command is refused on the etcd leader,
+and only one member can be defragmented at a time.
+Use etcd forfeit-leadership to move the leadership to another member first.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd defrag"); err != nil {
+				return err
+			}
+
+			resp, err := c.EtcdDefragment(ctx, &machine.EtcdDefragmentRequest{})
+			if err != nil {
+				return err
+			}
+
+			for _, msg := range resp.Messages {
+				fmt.Printf("etcd database defragmented: %s -> %s\n",
+					humanize.IBytes(uint64(msg.DbSizeBefore)), humanize.IBytes(uint64(msg.DbSizeAfter)))
+			}
+
+			return nil
+		})
+	},
+}
+
+var etcdCompactCmdFlags struct {
+	physical        bool
+	revision        int64
+	retainRevisions int64
+}
+
+var etcdCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact etcd key-value history keeping the most recent revisions",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd compact"); err != nil {
+				return err
+			}
+
+			resp, err := c.EtcdCompact(ctx, &machine.EtcdCompactRequest{
+				Physical:        etcdCompactCmdFlags.physical,
+				Revision:        etcdCompactCmdFlags.revision,
+				RetainRevisions: etcdCompactCmdFlags.retainRevisions,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, msg := range resp.Messages {
+				fmt.Printf("etcd history compacted at revision %d\n", msg.Revision)
+			}
+
+			return nil
+		})
+	},
+}
+
 var etcdMemberListCmd = &cobra.Command{
 	Use:   "members",
 	Short: "Get the list of etcd cluster members",
@@ -207,6 +272,11 @@ var etcdSnapshotCmd = &cobra.Command{
 }
 
 func init() {
-	etcdCmd.AddCommand(etcdLeaveCmd, etcdForfeitLeadershipCmd, etcdMemberListCmd, etcdMemberRemoveCmd, etcdSnapshotCmd)
+	etcdCompactCmd.Flags().BoolVar(&etcdCompactCmdFlags.physical, "physical", false, "wait for the compacted entries to be removed from the backend database")
+	etcdCompactCmd.Flags().Int64Var(&etcdCompactCmdFlags.revision, "revision", 0, "revision to compact the history up to (overrides --retain-revisions)")
+	etcdCompactCmd.Flags().Int64Var(&etcdCompactCmdFlags.retainRevisions, "retain-revisions", constants.EtcdCompactDefaultRetainRevisions,
+		"number of the most recent revisions to keep in the history")
+
+	etcdCmd.AddCommand(etcdLeaveCmd, etcdForfeitLeadershipCmd, etcdMemberListCmd, etcdMemberRemoveCmd, etcdSnapshotCmd, etcdDefragmentCmd, etcdCompactCmd)
 	addCommand(etcdCmd)
 }
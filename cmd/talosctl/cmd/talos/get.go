@@ -9,12 +9,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 
 	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -24,6 +29,11 @@ var getCmdFlags struct {
 	output string
 
 	watch bool
+
+	redacted bool
+
+	limit  uint32
+	fields []string
 }
 
 // getCmd represents the get (resources) command.
@@ -31,10 +41,15 @@ var getCmd = &cobra.Command{
 	Use:     "get <type> [<id>]",
 	Aliases: []string{"g"},
 	Short:   "Get a specific resource or list of resources.",
-	Long:    ``,
-	Args:    cobra.RangeArgs(1, 2),
+	Long: `Use 'talosctl get config' to show the machine configuration the node is running,
+secrets are redacted unless --redacted=false is given.`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if len(args) == 1 && args[0] == "config" && !getCmdFlags.watch {
+				return getMachineConfig(ctx, c)
+			}
+
 			out, err := output.NewWriter(getCmdFlags.output)
 			if err != nil {
 				return err
@@ -94,6 +109,11 @@ var getCmd = &cobra.Command{
 				}
 			}
 
+			// get <type> --limit/--fields
+			if resourceID == "" && (getCmdFlags.limit > 0 || len(getCmdFlags.fields) > 0) {
+				return getPaged(ctx, c, out, resourceType)
+			}
+
 			// get <type>
 			// get <type> <id>
 			printOut := func(parentCtx context.Context, msg client.ResourceResponse) error {
@@ -119,9 +139,161 @@ var getCmd = &cobra.Command{
 	},
 }
 
+// getPaged lists resources page by page.
+//
+// Continue tokens are only valid for a single node, so nodes are listed one by one.
+//
+//nolint:gocyclo,cyclop
+func getPaged(ctx context.Context, c *client.Client, out output.Writer, resourceType string) error {
+	nodeContexts := []context.Context{ctx}
+
+	if len(Nodes) > 1 {
+		nodeContexts = nil
+
+		for _, node := range Nodes {
+			nodeContexts = append(nodeContexts, client.WithNodes(ctx, node))
+		}
+	}
+
+	opts := client.ListOptions{
+		Limit:  getCmdFlags.limit,
+		Fields: getCmdFlags.fields,
+	}
+
+	var headerWritten bool
+
+	for _, nodeCtx := range nodeContexts {
+		opts.ContinueToken = ""
+
+		for {
+			listClient, err := c.Resources.ListPage(nodeCtx, getCmdFlags.namespace, resourceType, opts)
+			if err != nil {
+				return err
+			}
+
+			opts.ContinueToken = ""
+
+			for {
+				msg, err := listClient.Recv()
+				if err != nil {
+					if err == io.EOF || client.StatusCode(err) == codes.Canceled {
+						break
+					}
+
+					return err
+				}
+
+				if msg.Metadata.GetError() != "" {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", msg.Metadata.GetHostname(), msg.Metadata.GetError())
+
+					continue
+				}
+
+				if msg.Definition != nil && !headerWritten {
+					if err = out.WriteHeader(msg.Definition, false); err != nil {
+						return err
+					}
+
+					headerWritten = true
+
+					// table output only needs the fields of the printed columns for the next pages
+					if getCmdFlags.output == "table" && len(opts.Fields) == 0 {
+						opts.Fields = printColumnFields(msg.Definition)
+					}
+				}
+
+				if msg.Resource != nil {
+					if err = out.WriteResource(msg.Metadata.GetHostname(), msg.Resource, 0); err != nil {
+						return err
+					}
+				}
+
+				if msg.ContinueToken != "" {
+					opts.ContinueToken = msg.ContinueToken
+				}
+			}
+
+			if opts.ContinueToken == "" {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+var printColumnFieldRe = regexp.MustCompile(`^\{\.([^.\[}]+)`)
+
+// printColumnFields returns top-level spec fields used by the print columns of the resource definition.
+//
+// If the fields can't be figured out, nil is returned, so that the full spec is fetched.
+func printColumnFields(definition resource.Resource) []string {
+	spec, ok := definition.(*resource.Any).Value().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	columns, _ := spec["printColumns"].([]interface{}) //nolint:errcheck
+
+	fields := []string{}
+
+	for _, col := range columns {
+		column, _ := col.(map[string]interface{})  //nolint:errcheck
+		jsonPath, _ := column["jsonPath"].(string) //nolint:errcheck
+
+		matches := printColumnFieldRe.FindStringSubmatch(jsonPath)
+		if matches == nil {
+			return nil
+		}
+
+		fields = append(fields, matches[1])
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return fields
+}
+
+func getMachineConfig(ctx context.Context, c *client.Client) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.Config(ctx, getCmdFlags.redacted, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting machine configuration: %w", err)
+		}
+
+		cli.Warning("%s", err)
+	}
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+
+	for i, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		if i > 0 {
+			fmt.Println("---")
+		}
+
+		fmt.Printf("# node: %s\n", node)
+		fmt.Print(string(msg.Data))
+	}
+
+	return nil
+}
+
 func init() {
 	getCmd.Flags().StringVar(&getCmdFlags.namespace, "namespace", "", "resource namespace (default is to use default namespace per resource)")
 	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (table, yaml)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "watch resource changes")
+	getCmd.Flags().Uint32Var(&getCmdFlags.limit, "limit", 0, "fetch resources in pages of the specified size")
+	getCmd.Flags().StringSliceVar(&getCmdFlags.fields, "fields", nil, "top-level spec fields to fetch (default is to fetch the full spec)")
+	getCmd.Flags().BoolVar(&getCmdFlags.redacted, "redacted", true, "redact secrets in the machine configuration ('get config' only)")
 	addCommand(getCmd)
 }
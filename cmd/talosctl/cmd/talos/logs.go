@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	criconstants "github.com/containerd/cri/pkg/constants"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
@@ -26,6 +29,8 @@ import (
 var (
 	follow    bool
 	tailLines int32
+	since     time.Duration
+	logLevel  string
 )
 
 // logsCmd represents the logs command.
@@ -49,7 +54,28 @@ var logsCmd = &cobra.Command{
 				driver = common.ContainerDriver_CONTAINERD
 			}
 
-			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
+			req := &machine.LogsRequest{
+				Namespace: namespace,
+				Driver:    driver,
+				Id:        args[0],
+				Follow:    follow,
+				TailLines: tailLines,
+			}
+
+			if since > 0 {
+				req.Since = timestamppb.New(time.Now().Add(-since))
+			}
+
+			if logLevel != "" {
+				level, ok := machine.LogsRequest_Level_value[strings.ToUpper(logLevel)]
+				if !ok {
+					return fmt.Errorf("unknown log level %q", logLevel)
+				}
+
+				req.Level = machine.LogsRequest_Level(level)
+			}
+
+			stream, err := c.MachineClient.Logs(ctx, req)
 			if err != nil {
 				return fmt.Errorf("error fetching logs: %s", err)
 			}
@@ -187,6 +213,8 @@ func init() {
 	logsCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().DurationVar(&since, "since", 0, "only show the lines written within the duration, e.g. 10m (system services only)")
+	logsCmd.Flags().StringVar(&logLevel, "level", "", "only show the lines of this level or higher: debug, info, warning, error (system services only)")
 
 	logsCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
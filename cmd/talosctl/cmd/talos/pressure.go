@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// pressureCmd represents the pressure command.
+var pressureCmd = &cobra.Command{
+	Use:   "pressure",
+	Short: "Show pressure stall information (PSI)",
+	Long:  `Averages are percentages of time tasks were stalled on the resource over 10, 60 and 300 seconds.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Pressure(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting pressure stall information: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return pressureRender(&remotePeer, resp)
+		})
+	},
+}
+
+func pressureRender(remotePeer *peer.Peer, resp *machineapi.PressureResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tRESOURCE\tKIND\tAVG10\tAVG60\tAVG300\tTOTAL")
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, resource := range []struct {
+			name  string
+			stats *machineapi.PressureStats
+		}{
+			{"cpu", msg.Cpu},
+			{"memory", msg.Memory},
+			{"io", msg.Io},
+		} {
+			for _, line := range []struct {
+				kind string
+				line *machineapi.PressureLine
+			}{
+				{"some", resource.stats.GetSome()},
+				{"full", resource.stats.GetFull()},
+			} {
+				if line.line == nil {
+					continue
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t%.2f\t%.2f\t%d\n",
+					node,
+					resource.name,
+					line.kind,
+					line.line.Avg10,
+					line.line.Avg60,
+					line.line.Avg300,
+					line.line.Total,
+				)
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	addCommand(pressureCmd)
+}
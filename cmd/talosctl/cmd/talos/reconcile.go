@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// reconcileCmd represents the reconcile command.
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile <controller>",
+	Short: "Trigger immediate reconcile of the controller",
+	Long:  `Controller name can be found in the output of 'talosctl inspect dependencies', e.g. secrets.KubernetesController.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := c.ReconcileNow(ctx, args[0]); err != nil {
+				return fmt.Errorf("error executing reconcile: %s", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	addCommand(reconcileCmd)
+}
@@ -17,6 +17,7 @@ import (
 var resetCmdFlags struct {
 	graceful           bool
 	reboot             bool
+	force              bool
 	systemLabelsToWipe []string
 }
 
@@ -41,6 +42,7 @@ var resetCmd = &cobra.Command{
 				Graceful:               resetCmdFlags.graceful,
 				Reboot:                 resetCmdFlags.reboot,
 				SystemPartitionsToWipe: systemPartitionsToWipe,
+				Force:                  resetCmdFlags.force,
 			}); err != nil {
 				return fmt.Errorf("error executing reset: %s", err)
 			}
@@ -53,6 +55,7 @@ var resetCmd = &cobra.Command{
 func init() {
 	resetCmd.Flags().BoolVar(&resetCmdFlags.graceful, "graceful", true, "if true, attempt to cordon/drain node and leave etcd (if applicable)")
 	resetCmd.Flags().BoolVar(&resetCmdFlags.reboot, "reboot", false, "if true, reboot the node after resetting instead of shutting down")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.force, "force", false, "if true, skip the etcd quorum checks on control plane nodes")
 	resetCmd.Flags().StringSliceVar(&resetCmdFlags.systemLabelsToWipe, "system-labels-to-wipe", nil, "if set, just wipe selected system disk partitions by label but keep other partitions intact")
 	addCommand(resetCmd)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -130,6 +131,14 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 			fmt.Fprintf(w, "LAST HEALTH MESSAGE\t%s\n", svc.Health.LastMessage)
 		}
 
+		if len(svc.DependsOn) > 0 {
+			fmt.Fprintf(w, "DEPENDS ON\t%s\n", strings.Join(svc.DependsOn, ", "))
+		}
+
+		if len(svc.WaitingFor) > 0 {
+			fmt.Fprintf(w, "WAITING FOR\t%s\n", strings.Join(svc.WaitingFor, ", "))
+		}
+
 		label := "EVENTS"
 
 		for i := range svc.Events.Events {
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var supportCmdFlags struct {
+	output string
+}
+
+// supportCmd represents the support command.
+var supportCmd = &cobra.Command{
+	Use:   "support",
+	Short: "Download a support bundle with node diagnostics",
+	Long: `Collects machine configuration, kernel log, service logs, services, mounts, processes,
+network and controller status from the node into a single .tar.gz archive.
+
+Secrets (private keys, tokens, passwords, machine configuration secrets and
+patterns from .machine.redactPatterns) are redacted on the node.
+If '-' is given for --output, archive is written to stdout.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "support"); err != nil {
+				return err
+			}
+
+			r, errCh, err := c.SupportBundle(ctx)
+			if err != nil {
+				return fmt.Errorf("error collecting support bundle: %w", err)
+			}
+
+			//nolint:errcheck
+			defer r.Close()
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			if supportCmdFlags.output == "-" {
+				_, err = io.Copy(os.Stdout, r)
+
+				return err
+			}
+
+			f, err := os.Create(supportCmdFlags.output)
+			if err != nil {
+				return fmt.Errorf("error creating %q: %w", supportCmdFlags.output, err)
+			}
+
+			//nolint:errcheck
+			defer f.Close()
+
+			if _, err = io.Copy(f, r); err != nil {
+				return fmt.Errorf("error writing %q: %w", supportCmdFlags.output, err)
+			}
+
+			return f.Close()
+		})
+	},
+}
+
+func init() {
+	supportCmd.Flags().StringVarP(&supportCmdFlags.output, "output", "O", "support.tar.gz", "path to write the support bundle to ('-' for stdout)")
+	addCommand(supportCmd)
+}
@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var uncordonCmdFlags struct {
+	force bool
+}
+
+// uncordonCmd represents the uncordon command.
+var uncordonCmd = &cobra.Command{
+	Use:   "uncordon",
+	Short: "Mark the Kubernetes node as schedulable",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.Uncordon(ctx, &machine.UncordonRequest{
+				Force: uncordonCmdFlags.force,
+			}); err != nil {
+				return fmt.Errorf("error executing uncordon: %s", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	uncordonCmd.Flags().BoolVar(&uncordonCmdFlags.force, "force", false, "if true, uncordon the node even if it was cordoned not by Talos")
+	addCommand(uncordonCmd)
+}
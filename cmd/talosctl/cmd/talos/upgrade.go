@@ -17,12 +17,15 @@ import (
 
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/release"
 )
 
 var (
-	upgradeImage string
-	preserve     bool
-	stage        bool
+	upgradeImage          string
+	upgradeReleaseIndex   string
+	upgradeReleaseVersion string
+	preserve              bool
+	stage                 bool
 )
 
 // upgradeCmd represents the processes command.
@@ -38,14 +41,25 @@ var upgradeCmd = &cobra.Command{
 
 func init() {
 	upgradeCmd.Flags().StringVarP(&upgradeImage, "image", "i", "", "the container image to use for performing the install")
+	upgradeCmd.Flags().StringVar(&upgradeReleaseIndex, "release-index", "", "URL of the release index to look up the installer image in, overrides --image")
+	upgradeCmd.Flags().StringVar(&upgradeReleaseVersion, "release-version", release.Latest, "release version to look up in the release index")
 	upgradeCmd.Flags().BoolVarP(&preserve, "preserve", "p", false, "preserve data")
 	upgradeCmd.Flags().BoolVarP(&stage, "stage", "s", false, "stage the upgrade to perform it after a reboot")
-	upgradeCmd.Flags().BoolVarP(&force, "force", "f", false, "force the upgrade (skip checks on etcd health and members, might lead to data loss)")
+	upgradeCmd.Flags().BoolVarP(&force, "force", "f", false, "force the upgrade (skip checks on etcd health and members and on version compatibility, might lead to data loss)")
 	addCommand(upgradeCmd)
 }
 
 func upgrade() error {
 	return WithClient(func(ctx context.Context, c *client.Client) error {
+		if upgradeReleaseIndex != "" {
+			image, err := resolveInstallerImage(ctx)
+			if err != nil {
+				return err
+			}
+
+			upgradeImage = image
+		}
+
 		var remotePeer peer.Peer
 
 		// TODO: See if we can validate version and prevent starting upgrades to
@@ -77,3 +91,24 @@ func upgrade() error {
 		return w.Flush()
 	})
 }
+
+// resolveInstallerImage looks up the installer image of the requested release in the release index.
+func resolveInstallerImage(ctx context.Context) (string, error) {
+	index, err := release.Fetch(ctx, upgradeReleaseIndex)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := index.Resolve(upgradeReleaseVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if rel.InstallerImage == "" {
+		return "", fmt.Errorf("release %q doesn't specify the installer image", rel.Version)
+	}
+
+	fmt.Fprintf(os.Stderr, "using installer image %s of release %s\n", rel.InstallerImage, rel.Version)
+
+	return rel.InstallerImage, nil
+}
@@ -8,7 +8,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"net"
+	"os"
+	"os/signal"
 	"regexp"
+	"strings"
+	"syscall"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -29,7 +34,10 @@ import (
 	"github.com/talos-systems/talos/pkg/startup"
 )
 
-var rbacEnabled *bool
+var (
+	rbacEnabled   *bool
+	listenSubnets *string
+)
 
 func runDebugServer(ctx context.Context) {
 	const debugAddr = ":9981"
@@ -48,6 +56,7 @@ func Main() {
 	log.SetFlags(log.Lshortfile | log.Ldate | log.Lmicroseconds | log.Ltime)
 
 	rbacEnabled = flag.Bool("enable-rbac", false, "enable RBAC for Talos API")
+	listenSubnets = flag.String("listen-subnets", "", "comma-separated list of subnets Talos API is available on")
 
 	flag.Parse()
 
@@ -57,6 +66,19 @@ func Main() {
 		log.Fatalf("failed to seed RNG: %v", err)
 	}
 
+	var subnets []*net.IPNet
+
+	if *listenSubnets != "" {
+		for _, cidr := range strings.Split(*listenSubnets, ",") {
+			_, subnet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				log.Fatalf("failed to parse listen subnet: %v", err)
+			}
+
+			subnets = append(subnets, subnet)
+		}
+	}
+
 	runtimeConn, err := grpc.Dial("unix://"+constants.APIRuntimeSocketPath, grpc.WithInsecure())
 	if err != nil {
 		log.Fatalf("failed to dial runtime connection: %v", err)
@@ -87,6 +109,7 @@ func Main() {
 
 	// all existing streaming methods
 	for _, methodName := range []string{
+		"/machine.MachineService/ArchiveOut",
 		"/machine.MachineService/Copy",
 		"/machine.MachineService/DiskUsage",
 		"/machine.MachineService/Dmesg",
@@ -96,6 +119,8 @@ func Main() {
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
 		"/machine.MachineService/Read",
+		"/machine.MachineService/ReadFile",
+		"/machine.MachineService/SupportBundle",
 		"/resource.ResourceService/List",
 		"/resource.ResourceService/Watch",
 		"/os.OSService/Dmesg",
@@ -107,63 +132,94 @@ func Main() {
 	// register future pattern: method should have suffix "Stream"
 	router.RegisterStreamedRegex("Stream$")
 
-	var errGroup errgroup.Group
-
-	errGroup.Go(func() error {
-		mode := authz.Disabled
-		if *rbacEnabled {
-			mode = authz.Enabled
-		}
+	mode := authz.Disabled
+	if *rbacEnabled {
+		mode = authz.Enabled
+	}
 
-		injector := &authz.Injector{
-			Mode:   mode,
-			Logger: log.New(log.Writer(), "apid/authz/injector/http ", log.Flags()).Printf,
-		}
+	networkInjector := &authz.Injector{
+		Mode:   mode,
+		Logger: log.New(log.Writer(), "apid/authz/injector/http ", log.Flags()).Printf,
+	}
 
-		return factory.ListenAndServe(
-			router,
-			factory.Port(constants.ApidPort),
-			factory.WithDefaultLog(),
-			factory.ServerOptions(
-				grpc.Creds(
-					credentials.NewTLS(serverTLSConfig),
-				),
-				grpc.CustomCodec(proxy.Codec()), //nolint:staticcheck
-				grpc.UnknownServiceHandler(
-					proxy.TransparentHandler(
-						router.Director,
-						proxy.WithStreamedDetector(router.StreamedDetector),
-					)),
+	networkServer := factory.NewServer(
+		router,
+		factory.WithDefaultLog(),
+		factory.ServerOptions(
+			grpc.Creds(
+				credentials.NewTLS(serverTLSConfig),
 			),
-			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
-			factory.WithStreamInterceptor(injector.StreamInterceptor()),
-		)
-	})
+			grpc.CustomCodec(proxy.Codec()), //nolint:staticcheck
+			grpc.UnknownServiceHandler(
+				proxy.TransparentHandler(
+					router.Director,
+					proxy.WithStreamedDetector(router.StreamedDetector),
+				)),
+		),
+		factory.WithUnaryInterceptor(networkInjector.UnaryInterceptor()),
+		factory.WithStreamInterceptor(networkInjector.StreamInterceptor()),
+	)
+
+	networkListener, err := factory.NewListener(
+		factory.Port(constants.ApidPort),
+		factory.ListenSubnets(subnets...),
+	)
+	if err != nil {
+		log.Fatalf("error creating listener: %v", err)
+	}
+
+	socketInjector := &authz.Injector{
+		Mode:   authz.MetadataOnly,
+		Logger: log.New(log.Writer(), "apid/authz/injector/unix ", log.Flags()).Printf,
+	}
+
+	socketServer := factory.NewServer(
+		router,
+		factory.WithDefaultLog(),
+		factory.ServerOptions(
+			grpc.CustomCodec(proxy.Codec()), //nolint:staticcheck
+			grpc.UnknownServiceHandler(
+				proxy.TransparentHandler(
+					router.Director,
+					proxy.WithStreamedDetector(router.StreamedDetector),
+				)),
+		),
+		factory.WithUnaryInterceptor(socketInjector.UnaryInterceptor()),
+		factory.WithStreamInterceptor(socketInjector.StreamInterceptor()),
+	)
+
+	socketListener, err := factory.NewListener(
+		factory.Network("unix"),
+		factory.SocketPath(constants.APISocketPath),
+	)
+	if err != nil {
+		log.Fatalf("error creating listener: %v", err)
+	}
+
+	var errGroup errgroup.Group
 
 	errGroup.Go(func() error {
-		injector := &authz.Injector{
-			Mode:   authz.MetadataOnly,
-			Logger: log.New(log.Writer(), "apid/authz/injector/unix ", log.Flags()).Printf,
-		}
+		return networkServer.Serve(networkListener)
+	})
 
-		return factory.ListenAndServe(
-			router,
-			factory.Network("unix"),
-			factory.SocketPath(constants.APISocketPath),
-			factory.WithDefaultLog(),
-			factory.ServerOptions(
-				grpc.CustomCodec(proxy.Codec()), //nolint:staticcheck
-				grpc.UnknownServiceHandler(
-					proxy.TransparentHandler(
-						router.Director,
-						proxy.WithStreamedDetector(router.StreamedDetector),
-					)),
-			),
-			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
-			factory.WithStreamInterceptor(injector.StreamInterceptor()),
-		)
+	errGroup.Go(func() error {
+		return socketServer.Serve(socketListener)
 	})
 
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+		<-signals
+
+		// let in-flight calls complete before apid is killed
+		ctx, cancel := context.WithTimeout(context.Background(), constants.GRPCGracefulShutdownTimeout)
+		defer cancel()
+
+		factory.ServerGracefulStop(ctx, networkServer)
+		factory.ServerGracefulStop(ctx, socketServer)
+	}()
+
 	if err := errGroup.Wait(); err != nil {
 		log.Fatalf("listen: %v", err)
 	}
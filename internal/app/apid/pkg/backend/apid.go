@@ -57,6 +57,7 @@ func (a *APID) GetConnection(ctx context.Context) (context.Context, *grpc.Client
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetIdentityMetadata(md, authz.GetIdentity(ctx))
 
 	if authority := md[":authority"]; len(authority) > 0 {
 		md.Set("proxyfrom", authority...)
@@ -132,7 +132,7 @@ func (p *certificateProvider) GetCA() ([]byte, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	return p.apiCerts.TypedSpec().CA.Crt, nil
+	return p.apiCerts.TypedSpec().CABundle(), nil
 }
 
 func (p *certificateProvider) GetCertificate(h *stdlibtls.ClientHelloInfo) (*stdlibtls.Certificate, error) {
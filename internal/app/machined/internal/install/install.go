@@ -22,6 +22,7 @@ import (
 	"github.com/talos-systems/go-procfs/procfs"
 	"golang.org/x/sys/unix"
 
+	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	containerdrunner "github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
@@ -67,7 +68,7 @@ func RunInstallerContainer(disk, platform, ref string, configBytes []byte, reg c
 	}
 
 	if err != nil {
-		return err
+		return &installer.DownloadError{Err: err}
 	}
 
 	mounts := []specs.Mount{
@@ -100,6 +101,10 @@ func RunInstallerContainer(disk, platform, ref string, configBytes []byte, reg c
 		args = append(args, "--board="+*c)
 	}
 
+	if options.WipeMode != "" {
+		args = append(args, "--wipe-mode="+options.WipeMode)
+	}
+
 	for _, arg := range options.ExtraKernelArgs {
 		args = append(args, []string{"--extra-kernel-arg", arg}...)
 	}
@@ -168,6 +173,10 @@ func RunInstallerContainer(disk, platform, ref string, configBytes []byte, reg c
 
 	code := status.ExitCode()
 	if code != 0 {
+		if class := installer.ErrorFromExitCode(int(code)); class != nil {
+			return fmt.Errorf("task %q failed: exit code %d: %w", "upgrade", code, class)
+		}
+
 		return fmt.Errorf("task %q failed: exit code %d", "upgrade", code)
 	}
 
@@ -13,6 +13,7 @@ type Options struct {
 	Force           bool
 	Upgrade         bool
 	Zero            bool
+	WipeMode        string
 	ExtraKernelArgs []string
 }
 
@@ -79,6 +80,15 @@ func WithZero(b bool) Option {
 	}
 }
 
+// WithWipeMode sets the wipe mode option.
+func WithWipeMode(mode string) Option {
+	return func(o *Options) error {
+		o.WipeMode = mode
+
+		return nil
+	}
+}
+
 // WithExtraKernelArgs sets the extra args.
 func WithExtraKernelArgs(s []string) Option {
 	return func(o *Options) error {
@@ -52,6 +52,7 @@ func (s *Server) HealthCheck(in *clusterapi.HealthCheckRequest, srv clusterapi.C
 
 	md := metadata.New(nil)
 	authz.SetMetadata(md, authz.GetRoles(srv.Context()))
+	authz.SetIdentityMetadata(md, authz.GetIdentity(srv.Context()))
 	checkCtx = metadata.NewOutgoingContext(checkCtx, md)
 
 	if err := clusterState.resolve(checkCtx, k8sProvider); err != nil {
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/talos-systems/go-kmsg"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// dmesgFilter returns the function which checks whether the kernel log message matches the request filters.
+func dmesgFilter(req *machine.DmesgRequest) (func(kmsg.Message) bool, error) {
+	maxPriority := kmsg.Debug
+
+	if req.GetPriority() != "" {
+		var err error
+
+		if maxPriority, err = parsePriority(req.GetPriority()); err != nil {
+			return nil, err
+		}
+	}
+
+	var facilities map[kmsg.Facility]struct{}
+
+	if len(req.GetFacilities()) > 0 {
+		facilities = make(map[kmsg.Facility]struct{}, len(req.GetFacilities()))
+
+		for _, name := range req.GetFacilities() {
+			facility, err := parseFacility(name)
+			if err != nil {
+				return nil, err
+			}
+
+			facilities[facility] = struct{}{}
+		}
+	}
+
+	return func(msg kmsg.Message) bool {
+		// lower priority value is more severe
+		if msg.Priority > maxPriority {
+			return false
+		}
+
+		if facilities != nil {
+			if _, ok := facilities[msg.Facility]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}, nil
+}
+
+func parsePriority(name string) (kmsg.Priority, error) {
+	for p := kmsg.Emerg; p <= kmsg.Debug; p++ {
+		if p.String() == name {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown kernel log priority %q", name)
+}
+
+func parseFacility(name string) (kmsg.Facility, error) {
+	for f := kmsg.Kern; f <= kmsg.Local7; f++ {
+		if f.String() == name {
+			return f, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown kernel log facility %q", name)
+}
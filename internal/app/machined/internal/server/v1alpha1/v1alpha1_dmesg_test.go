@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/go-kmsg"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestDmesgFilter(t *testing.T) {
+	messages := []kmsg.Message{
+		{Facility: kmsg.Kern, Priority: kmsg.Err},
+		{Facility: kmsg.Kern, Priority: kmsg.Info},
+		{Facility: kmsg.Daemon, Priority: kmsg.Crit},
+		{Facility: kmsg.User, Priority: kmsg.Debug},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		req      *machine.DmesgRequest
+		expected []bool
+	}{
+		{
+			name:     "all",
+			req:      &machine.DmesgRequest{},
+			expected: []bool{true, true, true, true},
+		},
+		{
+			name:     "priority",
+			req:      &machine.DmesgRequest{Priority: "err"},
+			expected: []bool{true, false, true, false},
+		},
+		{
+			name:     "facilities",
+			req:      &machine.DmesgRequest{Facilities: []string{"kern", "user"}},
+			expected: []bool{true, true, false, true},
+		},
+		{
+			name:     "priority and facilities",
+			req:      &machine.DmesgRequest{Priority: "warning", Facilities: []string{"kern"}},
+			expected: []bool{true, false, false, false},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := dmesgFilter(tt.req)
+			require.NoError(t, err)
+
+			for i, msg := range messages {
+				assert.Equal(t, tt.expected[i], filter(msg), "%s.%s", msg.Facility, msg.Priority)
+			}
+		})
+	}
+
+	_, err := dmesgFilter(&machine.DmesgRequest{Priority: "error"})
+	assert.EqualError(t, err, "unknown kernel log priority \"error\"")
+
+	_, err = dmesgFilter(&machine.DmesgRequest{Facilities: []string{"kernel"}})
+	assert.EqualError(t, err, "unknown kernel log facility \"kernel\"")
+}
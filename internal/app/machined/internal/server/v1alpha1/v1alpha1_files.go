@@ -0,0 +1,356 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+	"github.com/talos-systems/talos/pkg/chunker/stream"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	readFileChunkSize = 4096
+
+	// archiveOutMaxSize is the maximum size of the archive produced by ArchiveOut.
+	archiveOutMaxSize = 256 * 1024 * 1024
+
+	// archiveOutTimeout is the maximum time ArchiveOut is allowed to run.
+	archiveOutTimeout = 5 * time.Minute
+)
+
+// allowedFilePrefixes is the list of directories ReadFile, List and ArchiveOut serve files from for non-admin callers.
+var allowedFilePrefixes = []string{
+	"/etc",
+	"/var/log",
+	"/system",
+}
+
+// deniedFilePrefixes is the list of directories and files which contain secrets.
+var deniedFilePrefixes = []string{
+	constants.StateMountPoint,
+	filepath.Dir(constants.KubebernetesStaticSecretsDir),
+	constants.DefaultCertificatesDir,
+	constants.KubeletBootstrapKubeconfig,
+	constants.KubeletKubeconfig,
+	constants.EncryptionConfigPath,
+}
+
+// readFileSecretMarkers are the strings which identify secrets in the file contents.
+var readFileSecretMarkers = [][]byte{
+	[]byte("PRIVATE KEY-----"),
+	[]byte("client-key-data"),
+	[]byte("token:"),
+}
+
+var errArchiveTooLarge = fmt.Errorf("archive exceeds the limit of %d bytes", archiveOutMaxSize)
+
+// ReadFile implements the machine.MachineServer interface.
+func (s *Server) ReadFile(in *machine.ReadRequest, srv machine.MachineService_ReadFileServer) error {
+	path, err := checkReadFilePath(in.Path)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !stat.Mode().IsRegular() {
+		return fmt.Errorf("path must be a regular file")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var (
+		buf     = make([]byte, readFileChunkSize)
+		scanner secretScanner
+	)
+
+	for {
+		n, readErr := f.Read(buf)
+
+		if n > 0 {
+			if scanner.Scan(buf[:n]) {
+				return status.Errorf(codes.PermissionDenied, "file %q looks like it contains a secret", in.Path)
+			}
+
+			if err = srv.Send(&common.Data{Bytes: append([]byte(nil), buf[:n]...)}); err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+// ArchiveOut implements the machine.MachineServer interface.
+func (s *Server) ArchiveOut(req *machine.ArchiveOutRequest, obj machine.MachineService_ArchiveOutServer) error {
+	root, err := resolveAllowedPath(req.RootPath)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	ctx, ctxCancel := context.WithTimeout(obj.Context(), archiveOutTimeout)
+	defer ctxCancel()
+
+	paths, err := archiver.Walker(ctx, root, archiver.WithSkipRoot())
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		//nolint:errcheck
+		defer pw.Close()
+
+		archiveErr := archiveOut(ctx, filterSecrets(ctx, paths), &limitedWriter{w: pw, n: archiveOutMaxSize}, req.Gzip)
+
+		// archive is incomplete if the timeout is hit between the files
+		if ctx.Err() != nil {
+			archiveErr = ctx.Err()
+		}
+
+		errCh <- archiveErr
+	}()
+
+	chunker := stream.NewChunker(ctx, pr)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		if err = obj.SendMsg(&common.Data{Bytes: data}); err != nil {
+			ctxCancel()
+		}
+	}
+
+	// unblock the archiver if the chunker stopped early
+	pr.Close() //nolint:errcheck
+
+	archiveErr := <-errCh
+	if archiveErr != nil {
+		return obj.SendMsg(&common.Data{
+			Metadata: &common.Metadata{
+				Error: archiveErr.Error(),
+			},
+		})
+	}
+
+	return nil
+}
+
+func archiveOut(ctx context.Context, paths <-chan archiver.FileItem, output io.Writer, compress bool) error {
+	if !compress {
+		return archiver.Tar(ctx, paths, output)
+	}
+
+	zw := gzip.NewWriter(output)
+	//nolint:errcheck
+	defer zw.Close()
+
+	if err := archiver.Tar(ctx, paths, zw); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// filterSecrets drops the files which might contain secrets from the archived paths.
+func filterSecrets(ctx context.Context, paths <-chan archiver.FileItem) <-chan archiver.FileItem {
+	ch := make(chan archiver.FileItem)
+
+	go func() {
+		defer close(ch)
+
+		for fi := range paths {
+			if isDeniedPath(fi.FullPath) {
+				continue
+			}
+
+			if fi.Error == nil && fi.FileInfo.Mode().IsRegular() {
+				if isKeyFile(fi.FullPath) {
+					continue
+				}
+
+				secret, err := fileContainsSecret(fi.FullPath)
+				if err != nil {
+					fi.Error = err
+				} else if secret {
+					continue
+				}
+			}
+
+			select {
+			case ch <- fi:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// checkReadFilePath returns the resolved path if it can be served by ReadFile.
+func checkReadFilePath(path string) (string, error) {
+	resolved, err := resolveAllowedPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	if isKeyFile(resolved) {
+		return "", fmt.Errorf("path %q looks like a key", path)
+	}
+
+	return resolved, nil
+}
+
+// resolveAllowedPath resolves symlinks in the path and checks it against the allowed and denied prefixes.
+func resolveAllowedPath(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %q should be absolute", path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+
+	if !hasPathPrefix(resolved, allowedFilePrefixes) {
+		return "", fmt.Errorf("path %q is not under %s", path, strings.Join(allowedFilePrefixes, ", "))
+	}
+
+	if isDeniedPath(resolved) {
+		return "", fmt.Errorf("path %q might contain secrets", path)
+	}
+
+	return resolved, nil
+}
+
+func hasPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDeniedPath checks the path against the deny-list shared by ReadFile, List and ArchiveOut.
+func isDeniedPath(path string) bool {
+	return hasPathPrefix(path, deniedFilePrefixes)
+}
+
+func isKeyFile(path string) bool {
+	base := filepath.Base(path)
+
+	return strings.HasSuffix(base, ".key") || strings.HasSuffix(base, "key.pem")
+}
+
+func fileContainsSecret(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var (
+		buf     = make([]byte, readFileChunkSize)
+		scanner secretScanner
+	)
+
+	for {
+		n, readErr := f.Read(buf)
+
+		if n > 0 && scanner.Scan(buf[:n]) {
+			return true, nil
+		}
+
+		if readErr == io.EOF {
+			return false, nil
+		}
+
+		if readErr != nil {
+			return false, readErr
+		}
+	}
+}
+
+// secretScanner looks for the secret markers in the data split into chunks.
+type secretScanner struct {
+	// tail of the previous chunk, as markers might span chunk boundaries
+	tail []byte
+}
+
+// Scan returns true if the chunk (together with the previous ones) contains a secret marker.
+func (scanner *secretScanner) Scan(chunk []byte) bool {
+	window := append(append([]byte(nil), scanner.tail...), chunk...)
+
+	tailSize := 0
+
+	for _, marker := range readFileSecretMarkers {
+		if bytes.Contains(window, marker) {
+			return true
+		}
+
+		if len(marker) > tailSize {
+			tailSize = len(marker)
+		}
+	}
+
+	if len(window) > tailSize {
+		window = window[len(window)-tailSize:]
+	}
+
+	scanner.tail = window
+
+	return false
+}
+
+// limitedWriter fails writes once more than n bytes were written.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > lw.n {
+		return 0, errArchiveTooLarge
+	}
+
+	lw.n -= int64(len(p))
+
+	return lw.w.Write(p)
+}
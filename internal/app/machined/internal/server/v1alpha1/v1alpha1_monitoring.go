@@ -133,6 +133,66 @@ func (s *Server) SystemStat(ctx context.Context, in *emptypb.Empty) (*machine.Sy
 	return reply, nil
 }
 
+// Pressure implements the machine.MachineServer interface.
+func (s *Server) Pressure(ctx context.Context, in *emptypb.Empty) (*machine.PressureResponse, error) {
+	fs, err := procfs.NewDefaultFS()
+	if err != nil {
+		return nil, err
+	}
+
+	translateLine := func(in *procfs.PSILine) *machine.PressureLine {
+		if in == nil {
+			return nil
+		}
+
+		return &machine.PressureLine{
+			Avg10:  in.Avg10,
+			Avg60:  in.Avg60,
+			Avg300: in.Avg300,
+			Total:  in.Total,
+		}
+	}
+
+	readPressure := func(resource string) (*machine.PressureStats, error) {
+		psi, e := fs.PSIStatsForResource(resource)
+		if e != nil {
+			return nil, e
+		}
+
+		return &machine.PressureStats{
+			Some: translateLine(psi.Some),
+			Full: translateLine(psi.Full),
+		}, nil
+	}
+
+	cpu, err := readPressure("cpu")
+	if err != nil {
+		return nil, err
+	}
+
+	memory, err := readPressure("memory")
+	if err != nil {
+		return nil, err
+	}
+
+	io, err := readPressure("io")
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &machine.PressureResponse{
+		Messages: []*machine.Pressure{
+			{
+				Cpu:    cpu,
+				Memory: memory,
+				Io:     io,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
 // CPUInfo implements the machine.MachineServer interface.
 func (s *Server) CPUInfo(ctx context.Context, in *emptypb.Empty) (*machine.CPUInfoResponse, error) {
 	fs, err := procfs.NewDefaultFS()
@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -232,12 +233,38 @@ func (s *ResourceServer) List(in *resourceapi.ListRequest, srv resourceapi.Resou
 		return err
 	}
 
-	for _, r := range list.Items {
+	// sort by ID, so that the continue token (last returned ID) is stable across the calls
+	items := list.Items
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Metadata().ID() < items[j].Metadata().ID()
+	})
+
+	if in.GetContinueToken() != "" {
+		items = items[sort.Search(len(items), func(i int) bool {
+			return items[i].Metadata().ID() > in.GetContinueToken()
+		}):]
+	}
+
+	var continueToken string
+
+	if in.GetLimit() > 0 && len(items) > int(in.GetLimit()) {
+		items = items[:in.GetLimit()]
+		continueToken = items[len(items)-1].Metadata().ID()
+	}
+
+	for _, r := range items {
 		protoR, err := marshalResource(r)
 		if err != nil {
 			return err
 		}
 
+		if len(in.GetFields()) > 0 {
+			if protoR.Spec.Yaml, err = projectSpec(protoR.Spec.Yaml, in.GetFields()); err != nil {
+				return err
+			}
+		}
+
 		if err = srv.Send(&resourceapi.ListResponse{
 			Resource: protoR,
 		}); err != nil {
@@ -245,9 +272,35 @@ func (s *ResourceServer) List(in *resourceapi.ListRequest, srv resourceapi.Resou
 		}
 	}
 
+	if continueToken != "" {
+		return srv.Send(&resourceapi.ListResponse{
+			ContinueToken: continueToken,
+		})
+	}
+
 	return nil
 }
 
+// projectSpec leaves only the specified top-level fields in the marshaled spec.
+func projectSpec(spec []byte, fields []string) ([]byte, error) {
+	var value map[string]interface{}
+
+	if err := yaml.Unmarshal(spec, &value); err != nil || value == nil {
+		// not a map, nothing to project
+		return spec, nil //nolint:nilerr
+	}
+
+	projected := make(map[string]interface{}, len(fields))
+
+	for _, field := range fields {
+		if v, ok := value[field]; ok {
+			projected[field] = v
+		}
+	}
+
+	return yaml.Marshal(projected)
+}
+
 // Watch implements resource.ResourceServiceServer interface.
 //
 //nolint:gocyclo
@@ -310,7 +363,16 @@ func (s *ResourceServer) Watch(in *resourceapi.WatchRequest, srv resourceapi.Res
 		return fmt.Errorf("error setting up watch: %w", err)
 	}
 
-	for event := range eventCh {
+	for {
+		var event state.Event
+
+		// stop as soon as the client goes away, even if there are no new events
+		select {
+		case <-ctx.Done():
+			return nil
+		case event = <-eventCh:
+		}
+
 		protoR, err := marshalResource(event.Resource)
 		if err != nil {
 			return err
@@ -333,6 +395,4 @@ func (s *ResourceServer) Watch(in *resourceapi.WatchRequest, srv resourceapi.Res
 			return err
 		}
 	}
-
-	return nil
 }
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectSpec(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		spec     string
+		fields   []string
+		expected string
+	}{
+		{
+			name:     "Map",
+			spec:     "address: 10.5.0.2/24\nlinkName: eth0\nfamily: inet4\n",
+			fields:   []string{"address", "linkName", "scope"},
+			expected: "address: 10.5.0.2/24\nlinkName: eth0\n",
+		},
+		{
+			name:     "Scalar",
+			spec:     "eth0\n",
+			fields:   []string{"address"},
+			expected: "eth0\n",
+		},
+		{
+			name:     "Empty",
+			spec:     "",
+			fields:   []string{"address"},
+			expected: "",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			projected, err := projectSpec([]byte(tt.spec), tt.fields)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, string(projected))
+		})
+	}
+}
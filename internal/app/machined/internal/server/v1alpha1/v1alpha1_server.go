@@ -18,6 +18,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
 	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/docker/distribution/reference"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/prometheus/procfs"
 	"github.com/rs/xid"
@@ -59,9 +61,12 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/redact"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/kubernetes"
 	"github.com/talos-systems/talos/pkg/machinery/api/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/inspect"
@@ -72,6 +77,8 @@ import (
 	timeapi "github.com/talos-systems/talos/pkg/machinery/api/time"
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configdiff"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -309,6 +316,21 @@ func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (*ma
 	}, nil
 }
 
+// ReconcileNow implements the machine.MachineServer interface.
+func (s *Server) ReconcileNow(ctx context.Context, in *machine.ReconcileNowRequest) (*machine.ReconcileNowResponse, error) {
+	log.Printf("reconcile request received for %q", in.GetController())
+
+	if err := s.Controller.V1Alpha2().QueueReconcile(in.GetController()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &machine.ReconcileNowResponse{
+		Messages: []*machine.ReconcileNow{
+			{},
+		},
+	}, nil
+}
+
 // Bootstrap implements the machine.MachineServer interface.
 func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (reply *machine.BootstrapResponse, err error) {
 	log.Printf("bootstrap request received")
@@ -345,6 +367,89 @@ func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (r
 	return reply, nil
 }
 
+// Config implements the machine.MachineServer interface.
+func (s *Server) Config(ctx context.Context, in *machine.ConfigRequest) (*machine.ConfigResponse, error) {
+	if !in.Redacted && !authz.GetRoles(ctx).Includes(role.Admin) {
+		return nil, authz.ErrNotAuthorized
+	}
+
+	cfg := s.Controller.Runtime().Config()
+	if cfg == nil {
+		return nil, status.Error(codes.FailedPrecondition, "machine configuration is not loaded yet")
+	}
+
+	data, err := cfg.Bytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Redacted {
+		var redactor *redact.Redactor
+
+		redactor, err = redact.ForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		data = redactor.Redact(data)
+	}
+
+	return &machine.ConfigResponse{
+		Messages: []*machine.Config{
+			{
+				Data: data,
+			},
+		},
+	}, nil
+}
+
+// ConfigDiff implements the machine.MachineServer interface.
+func (s *Server) ConfigDiff(ctx context.Context, in *machine.ConfigDiffRequest) (*machine.ConfigDiffResponse, error) {
+	current := s.Controller.Runtime().Config()
+	if current == nil {
+		return nil, status.Error(codes.FailedPrecondition, "machine configuration is not loaded yet")
+	}
+
+	data := in.GetData()
+
+	if len(data) == 0 {
+		var err error
+
+		data, err = ioutil.ReadFile(constants.ConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading staged config: %w", err)
+		}
+	}
+
+	next, err := s.Controller.Runtime().ValidateConfig(data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changes, err := configdiff.Diff(current, next)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &machine.ConfigDiff{
+		RebootRequired: configdiff.RebootRequired(changes),
+	}
+
+	for _, change := range changes {
+		reply.Changes = append(reply.Changes, &machine.ConfigChange{
+			Path:           change.Path,
+			Op:             machine.ConfigChange_Op(change.Op),
+			RebootRequired: change.RebootRequired,
+		})
+	}
+
+	return &machine.ConfigDiffResponse{
+		Messages: []*machine.ConfigDiff{
+			reply,
+		},
+	}, nil
+}
+
 // Shutdown implements the machine.MachineServer interface.
 //
 //nolint:dupl
@@ -390,9 +495,20 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 
 	log.Printf("upgrade request received: preserve %v, staged %v, force %v", in.GetPreserve(), in.GetStage(), in.GetForce())
 
+	if !in.GetForce() {
+		if err = checkUpgradeVersion(in.GetImage()); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Printf("validating %q", in.GetImage())
 
 	if err = pullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
+		if errors.Is(err, installer.ErrDownloadFailed) {
+			// download might succeed if retried later
+			return nil, status.Errorf(codes.Unavailable, "error validating installer image %q: %s", in.GetImage(), err)
+		}
+
 		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
 	}
 
@@ -417,7 +533,7 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 		if err = client.ValidateForUpgrade(ctx, s.Controller.Runtime().Config(), in.GetPreserve()); err != nil {
 			mu.Unlock(ctx) //nolint:errcheck
 
-			return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
+			return nil, status.Errorf(codes.FailedPrecondition, "error validating etcd for upgrade, use force to override: %s", err)
 		}
 	}
 
@@ -525,7 +641,13 @@ func (opt *ResetOptions) GetSystemDiskTargets() []runtime.PartitionTarget {
 //
 //nolint:gocyclo
 func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *machine.ResetResponse, err error) {
-	log.Printf("reset request received")
+	log.Printf("reset request received: graceful %v, force %v", in.GetGraceful(), in.GetForce())
+
+	if in.GetGraceful() && !in.GetForce() && s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeWorker {
+		if err = s.validateForRemoval(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	opts := ResetOptions{
 		ResetRequest: in,
@@ -595,6 +717,79 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 	return reply, nil
 }
 
+// Cordon implements the machine.MachineServer interface.
+func (s *Server) Cordon(ctx context.Context, in *machine.CordonRequest) (reply *machine.CordonResponse, err error) {
+	if err = s.withKubernetesNode(func(kubeHelper *kubernetes.Client, nodename string) error {
+		return kubeHelper.Cordon(ctx, nodename)
+	}); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.CordonResponse{
+		Messages: []*machine.Cordon{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// Drain implements the machine.MachineServer interface.
+func (s *Server) Drain(ctx context.Context, in *machine.DrainRequest) (reply *machine.DrainResponse, err error) {
+	drainOpts := kubernetes.DrainOptions{
+		Timeout: in.GetTimeout().AsDuration(),
+		Force:   in.GetForce(),
+	}
+
+	if err = s.withKubernetesNode(func(kubeHelper *kubernetes.Client, nodename string) error {
+		return kubeHelper.DrainWithOptions(ctx, nodename, drainOpts)
+	}); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.DrainResponse{
+		Messages: []*machine.Drain{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// Uncordon implements the machine.MachineServer interface.
+func (s *Server) Uncordon(ctx context.Context, in *machine.UncordonRequest) (reply *machine.UncordonResponse, err error) {
+	if err = s.withKubernetesNode(func(kubeHelper *kubernetes.Client, nodename string) error {
+		return kubeHelper.Uncordon(ctx, nodename, in.GetForce())
+	}); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.UncordonResponse{
+		Messages: []*machine.Uncordon{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// withKubernetesNode runs f with the Kubernetes client authenticated as the kubelet of the node.
+func (s *Server) withKubernetesNode(f func(kubeHelper *kubernetes.Client, nodename string) error) error {
+	nodename, err := s.Controller.Runtime().NodeName()
+	if err != nil {
+		return fmt.Errorf("failed to get node name: %w", err)
+	}
+
+	kubeHelper, err := kubernetes.NewClientFromKubeletKubeconfig()
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to create Kubernetes client: %s", err)
+	}
+
+	defer kubeHelper.Close() //nolint:errcheck
+
+	return f(kubeHelper, nodename)
+}
+
 // ServiceList returns list of the registered services and their status.
 func (s *Server) ServiceList(ctx context.Context, in *emptypb.Empty) (result *machine.ServiceListResponse, err error) {
 	services := system.Services(s.Controller.Runtime()).List()
@@ -713,8 +908,6 @@ func (s *Server) Copy(req *machine.CopyRequest, obj machine.MachineService_CopyS
 }
 
 // List implements the machine.MachineServer interface.
-//
-//nolint:gocyclo
 func (s *Server) List(req *machine.ListRequest, obj machine.MachineService_ListServer) error {
 	if req == nil {
 		req = new(machine.ListRequest)
@@ -730,6 +923,26 @@ func (s *Server) List(req *machine.ListRequest, obj machine.MachineService_ListS
 		req.Root = "/"
 	}
 
+	var skip func(path string) bool
+
+	// callers without the admin role can only list the paths ReadFile serves
+	if !authz.GetRoles(obj.Context()).Includes(role.Admin) {
+		root, err := resolveAllowedPath(req.Root)
+		if err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		req.Root = root
+		skip = isDeniedPath
+	}
+
+	return listFiles(req, obj, skip)
+}
+
+// listFiles walks the file tree under the req.Root, skipping the paths matched by skip.
+//
+//nolint:gocyclo
+func listFiles(req *machine.ListRequest, obj machine.MachineService_ListServer, skip func(path string) bool) error {
 	var recursionDepth int
 
 	if req.Recurse {
@@ -767,6 +980,10 @@ func (s *Server) List(req *machine.ListRequest, obj machine.MachineService_ListS
 	}
 
 	for fi := range files {
+		if skip != nil && skip(fi.FullPath) {
+			continue
+		}
+
 		if fi.Error != nil {
 			err = obj.Send(&machine.FileInfo{
 				Name:         fi.FullPath,
@@ -1113,8 +1330,17 @@ func (s *Server) Kubeconfig(empty *emptypb.Empty, obj machine.MachineService_Kub
 	})
 }
 
+var logLevels = map[machine.LogsRequest_Level]runtime.LogLevel{
+	machine.LogsRequest_DEBUG:   runtime.LogLevelDebug,
+	machine.LogsRequest_INFO:    runtime.LogLevelInfo,
+	machine.LogsRequest_WARNING: runtime.LogLevelWarning,
+	machine.LogsRequest_ERROR:   runtime.LogLevelError,
+}
+
 // Logs provides a service or container logs can be requested and the contents of the
 // log file are streamed in chunks.
+//
+//nolint:gocyclo
 func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsServer) (err error) {
 	var chunk chunker.Chunker
 
@@ -1130,6 +1356,14 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 			options = append(options, runtime.WithTailLines(int(req.TailLines)))
 		}
 
+		if req.Since != nil {
+			options = append(options, runtime.WithSince(req.Since.AsTime()))
+		}
+
+		if req.Level != machine.LogsRequest_UNKNOWN {
+			options = append(options, runtime.WithLevel(logLevels[req.Level]))
+		}
+
 		var logR io.ReadCloser
 
 		logR, err = s.Controller.Runtime().Logging().ServiceLog(req.Id).Reader(options...)
@@ -1142,6 +1376,10 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 
 		chunk = stream.NewChunker(l.Context(), logR)
 	default:
+		if req.Since != nil || req.Level != machine.LogsRequest_UNKNOWN {
+			return status.Error(codes.InvalidArgument, "since and level are only supported for system service logs")
+		}
+
 		var file io.Closer
 
 		if chunk, file, err = k8slogs(l.Context(), req); err != nil {
@@ -1289,6 +1527,27 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 	return <-errCh
 }
 
+// checkUpgradeVersion verifies that the version of the installer image is a supported upgrade from the running version.
+func checkUpgradeVersion(image string) error {
+	ref, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "error parsing installer image reference %q: %s", image, err)
+	}
+
+	tagged, ok := ref.(reference.Tagged)
+	if !ok {
+		log.Printf("installer image %q is not tagged, skipping version compatibility check", image)
+
+		return nil
+	}
+
+	if err = version.CheckUpgrade(version.Tag, tagged.Tag()); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%s, use force to skip the check", err)
+	}
+
+	return nil
+}
+
 func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
@@ -1300,7 +1559,7 @@ func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, r
 
 	img, err := image.Pull(containerdctx, reg, client, ref)
 	if err != nil {
-		return err
+		return &installer.DownloadError{Err: err}
 	}
 
 	// Launch the container with a known help command for a simple check to make sure the image is valid
@@ -1391,14 +1650,22 @@ func (s *Server) Containers(ctx context.Context, in *machine.ContainersRequest)
 				Pid:       container.Pid,
 				Status:    container.Status,
 			}
+
+			if in.Filter != "" && !containerMatches(container, in.Filter) {
+				continue
+			}
+
 			containers = append(containers, container)
 		}
 	}
 
+	containers, continueToken := containersPage(containers, in.Limit, in.ContinueToken)
+
 	reply = &machine.ContainersResponse{
 		Messages: []*machine.Container{
 			{
-				Containers: containers,
+				Containers:    containers,
+				ContinueToken: continueToken,
 			},
 		},
 	}
@@ -1406,6 +1673,44 @@ func (s *Server) Containers(ctx context.Context, in *machine.ContainersRequest)
 	return reply, nil
 }
 
+func containerMatches(container *machine.ContainerInfo, filter string) bool {
+	for _, field := range []string{container.Id, container.PodId, container.Name, container.Image} {
+		if strings.Contains(field, filter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containersPage returns the page of containers after the continue token.
+//
+// Containers are sorted by ID, and the continue token is the ID of the last container in the page.
+// If neither the limit nor the continue token is set, containers are returned as is.
+func containersPage(containers []*machine.ContainerInfo, limit uint32, continueToken string) ([]*machine.ContainerInfo, string) {
+	if limit == 0 && continueToken == "" {
+		return containers, ""
+	}
+
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].Id < containers[j].Id
+	})
+
+	if continueToken != "" {
+		containers = containers[sort.Search(len(containers), func(i int) bool {
+			return containers[i].Id > continueToken
+		}):]
+	}
+
+	if limit == 0 || len(containers) <= int(limit) {
+		return containers, ""
+	}
+
+	containers = containers[:limit]
+
+	return containers, containers[len(containers)-1].Id
+}
+
 // Stats implements the machine.MachineServer interface.
 func (s *Server) Stats(ctx context.Context, in *machine.StatsRequest) (reply *machine.StatsResponse, err error) {
 	inspector, err := getContainerInspector(ctx, in.Namespace, in.Driver)
@@ -1493,6 +1798,11 @@ func (s *Server) Restart(ctx context.Context, in *machine.RestartRequest) (*mach
 func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_DmesgServer) error {
 	ctx := srv.Context()
 
+	filter, err := dmesgFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var options []kmsg.Option
 
 	if req.Follow {
@@ -1530,6 +1840,11 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 				})
 			} else {
 				msg := packet.Message
+
+				if !filter(msg) {
+					continue
+				}
+
 				err = srv.Send(&common.Data{
 					Bytes: []byte(fmt.Sprintf("%s: %7s: [%s]: %s", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)),
 				})
@@ -1844,6 +2159,124 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 	return nil
 }
 
+// EtcdDefragment implements the machine.MachineServer interface.
+func (s *Server) EtcdDefragment(ctx context.Context, in *machine.EtcdDefragmentRequest) (reply *machine.EtcdDefragmentResponse, err error) {
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	endpoint := client.Endpoints()[0]
+
+	status, err := client.Status(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd member status: %w", err)
+	}
+
+	// defragmentation blocks the member, defragmenting the leader stalls the whole cluster
+	if status.Leader == status.Header.MemberId {
+		members, err := client.MemberList(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list etcd members: %w", err)
+		}
+
+		if len(members.Members) > 1 {
+			return nil, fmt.Errorf("refusing to defragment etcd leader, forfeit leadership first")
+		}
+	}
+
+	reply = &machine.EtcdDefragmentResponse{
+		Messages: []*machine.EtcdDefragment{
+			{
+				DbSizeBefore: status.DbSize,
+			},
+		},
+	}
+
+	if err = withEtcdMaintenanceLock(ctx, client, func() error {
+		if _, err = client.Defragment(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed to defragment: %w", err)
+		}
+
+		if status, err = client.Status(ctx, endpoint); err != nil {
+			return fmt.Errorf("failed to get etcd member status: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	reply.Messages[0].DbSizeAfter = status.DbSize
+
+	return reply, nil
+}
+
+// EtcdCompact implements the machine.MachineServer interface.
+func (s *Server) EtcdCompact(ctx context.Context, in *machine.EtcdCompactRequest) (reply *machine.EtcdCompactResponse, err error) {
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	ctx = clientv3.WithRequireLeader(ctx)
+
+	var revision int64
+
+	if err = withEtcdMaintenanceLock(ctx, client, func() error {
+		var status *clientv3.StatusResponse
+
+		if status, err = client.Status(ctx, client.Endpoints()[0]); err != nil {
+			return fmt.Errorf("failed to get etcd member status: %w", err)
+		}
+
+		revision = in.GetRevision()
+
+		if revision == 0 {
+			retain := in.GetRetainRevisions()
+			if retain == 0 {
+				retain = constants.EtcdCompactDefaultRetainRevisions
+			}
+
+			revision = status.Header.Revision - retain
+		}
+
+		if revision <= 0 {
+			return fmt.Errorf("nothing to compact: current revision is %d", status.Header.Revision)
+		}
+
+		var opts []clientv3.CompactOption
+
+		if in.GetPhysical() {
+			opts = append(opts, clientv3.WithCompactPhysical())
+		}
+
+		if _, err = client.Compact(ctx, revision, opts...); err != nil {
+			return fmt.Errorf("failed to compact: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	reply = &machine.EtcdCompactResponse{
+		Messages: []*machine.EtcdCompact{
+			{
+				Revision: revision,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
 // EtcdRecover implements the machine.MachineServer interface.
 func (s *Server) EtcdRecover(srv machine.MachineService_EtcdRecoverServer) error {
 	snapshot, err := os.OpenFile(constants.EtcdRecoverySnapshotPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o700)
@@ -1970,6 +2403,70 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 	return reply, nil
 }
 
+// withEtcdMaintenanceLock runs f holding the cluster-wide etcd maintenance lock.
+//
+// Lock is not waited for: maintenance is refused if it is already in progress on another member.
+func withEtcdMaintenanceLock(ctx context.Context, c *etcd.Client, f func() error) error {
+	sess, err := concurrency.NewSession(c.Client)
+	if err != nil {
+		return fmt.Errorf("failed to create etcd session: %w", err)
+	}
+
+	//nolint:errcheck
+	defer sess.Close()
+
+	mu := concurrency.NewMutex(sess, constants.EtcdTalosMaintenanceMutex)
+
+	if err = mu.TryLock(ctx); err != nil {
+		if errors.Is(err, concurrency.ErrLocked) {
+			return fmt.Errorf("etcd maintenance is already in progress")
+		}
+
+		return fmt.Errorf("failed to acquire etcd maintenance lock: %w", err)
+	}
+
+	//nolint:errcheck
+	defer mu.Unlock(context.Background())
+
+	return f()
+}
+
+// validateForRemoval checks that the control plane node can leave the cluster without losing etcd quorum.
+//
+// If etcd can't be reached, the check is skipped with a warning, as reset might be the way to recover the node.
+func (s *Server) validateForRemoval(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, etcd.QuorumCheckTimeout)
+	defer cancel()
+
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().Config().Cluster().CA(), s.Controller.Runtime().Config().Cluster().Endpoint())
+	if err != nil {
+		log.Printf("WARNING: skipping etcd quorum check, failed to create etcd client: %s", err)
+
+		return nil
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		log.Printf("WARNING: skipping etcd quorum check, failed to list etcd members: %s", err)
+
+		return nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	if err = etcd.ValidateForRemoval(ctx, resp.Members, hostname); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "error validating etcd for reset, use force to override: %s", err)
+	}
+
+	return nil
+}
+
 func upgradeMutex(c *etcd.Client) (*concurrency.Mutex, error) {
 	sess, err := concurrency.NewSession(c.Client,
 		concurrency.WithTTL(MinimumEtcdUpgradeLeaseLockSeconds),
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestContainersPage(t *testing.T) {
+	containers := func() []*machine.ContainerInfo {
+		return []*machine.ContainerInfo{
+			{Id: "kube-system/kube-proxy-x", Image: "k8s.gcr.io/kube-proxy:v1.21.2"},
+			{Id: "kube-system/coredns-a", Image: "k8s.gcr.io/coredns:1.8.0"},
+			{Id: "kube-system/coredns-a:coredns", Name: "coredns", PodId: "kube-system/coredns-a", Image: "k8s.gcr.io/coredns:1.8.0"},
+			{Id: "kube-system/kube-flannel-y", Image: "quay.io/coreos/flannel:v0.14.0"},
+		}
+	}
+
+	ids := func(containers []*machine.ContainerInfo) []string {
+		result := make([]string, len(containers))
+
+		for i := range containers {
+			result[i] = containers[i].Id
+		}
+
+		return result
+	}
+
+	page, token := containersPage(containers(), 0, "")
+	assert.Equal(t, ids(containers()), ids(page))
+	assert.Empty(t, token)
+
+	page, token = containersPage(containers(), 10, "")
+	assert.Equal(t, []string{"kube-system/coredns-a", "kube-system/coredns-a:coredns", "kube-system/kube-flannel-y", "kube-system/kube-proxy-x"}, ids(page))
+	assert.Empty(t, token)
+
+	page, token = containersPage(containers(), 3, "")
+	assert.Equal(t, []string{"kube-system/coredns-a", "kube-system/coredns-a:coredns", "kube-system/kube-flannel-y"}, ids(page))
+	assert.Equal(t, "kube-system/kube-flannel-y", token)
+
+	page, token = containersPage(containers(), 3, token)
+	assert.Equal(t, []string{"kube-system/kube-proxy-x"}, ids(page))
+	assert.Empty(t, token)
+
+	var matched []string
+
+	for _, container := range containers() {
+		if containerMatches(container, "coredns") {
+			matched = append(matched, container.Id)
+		}
+	}
+
+	assert.Equal(t, []string{"kube-system/coredns-a", "kube-system/coredns-a:coredns"}, matched)
+	assert.True(t, containerMatches(containers()[3], "quay.io"))
+}
@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/talos-systems/go-kmsg"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/redact"
+	"github.com/talos-systems/talos/pkg/chunker/stream"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/resources/network"
+	resourcev1alpha1 "github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+// supportBundleTimeout is the maximum time SupportBundle is allowed to run.
+const supportBundleTimeout = 5 * time.Minute
+
+// supportBundleResources is the list of resources included into the support bundle.
+var supportBundleResources = []struct {
+	namespace resource.Namespace
+	typ       resource.Type
+}{
+	{network.NamespaceName, network.AddressStatusType},
+	{network.NamespaceName, network.LinkStatusType},
+	{network.NamespaceName, network.RouteStatusType},
+	{resourcev1alpha1.NamespaceName, resourcev1alpha1.ControllerStatusType},
+}
+
+// supportBundle collects the support bundle files into the tar archive.
+type supportBundle struct {
+	tw       *tar.Writer
+	redactor *redact.Redactor
+	errs     bytes.Buffer
+}
+
+// SupportBundle implements the machine.MachineServer interface.
+func (s *Server) SupportBundle(in *emptypb.Empty, obj machine.MachineService_SupportBundleServer) error {
+	ctx, ctxCancel := context.WithTimeout(obj.Context(), supportBundleTimeout)
+	defer ctxCancel()
+
+	pr, pw := io.Pipe()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		//nolint:errcheck
+		defer pw.Close()
+
+		errCh <- s.writeSupportBundle(ctx, pw)
+	}()
+
+	chunker := stream.NewChunker(ctx, pr)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		if err := obj.SendMsg(&common.Data{Bytes: data}); err != nil {
+			ctxCancel()
+		}
+	}
+
+	// unblock the writer if the chunker stopped early
+	pr.Close() //nolint:errcheck
+
+	if err := <-errCh; err != nil {
+		return obj.SendMsg(&common.Data{
+			Metadata: &common.Metadata{
+				Error: err.Error(),
+			},
+		})
+	}
+
+	return nil
+}
+
+func (s *Server) writeSupportBundle(ctx context.Context, w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	//nolint:errcheck
+	defer zw.Close()
+
+	cfg := s.Controller.Runtime().Config()
+
+	redactor, err := newSupportRedactor(cfg)
+	if err != nil {
+		return fmt.Errorf("error building redactor: %w", err)
+	}
+
+	bundle := &supportBundle{
+		tw:       tar.NewWriter(zw),
+		redactor: redactor,
+	}
+
+	//nolint:errcheck
+	defer bundle.tw.Close()
+
+	if cfg != nil {
+		bundle.collect("config.yaml", func() ([]byte, error) {
+			return cfg.Bytes(encoder.WithComments(encoder.CommentsDisabled))
+		})
+	}
+
+	bundle.collect("dmesg.log", func() ([]byte, error) {
+		return readDmesg(ctx)
+	})
+
+	for _, svc := range system.Services(s.Controller.Runtime()).List() {
+		id := svc.AsProto().Id
+
+		bundle.collect(path.Join("service-logs", id+".log"), func() ([]byte, error) {
+			r, logErr := s.Controller.Runtime().Logging().ServiceLog(id).Reader()
+			if logErr != nil {
+				return nil, logErr
+			}
+
+			//nolint:errcheck
+			defer r.Close()
+
+			return ioutil.ReadAll(r)
+		})
+	}
+
+	bundle.collect("services.json", func() ([]byte, error) {
+		return marshalSupportMessage(s.ServiceList(ctx, &emptypb.Empty{}))
+	})
+
+	bundle.collect("mounts.json", func() ([]byte, error) {
+		return marshalSupportMessage(s.Mounts(ctx, &emptypb.Empty{}))
+	})
+
+	bundle.collect("processes.json", func() ([]byte, error) {
+		return marshalSupportMessage(s.Processes(ctx, &emptypb.Empty{}))
+	})
+
+	for _, res := range supportBundleResources {
+		res := res
+
+		bundle.collect(path.Join("resources", string(res.typ)+".yaml"), func() ([]byte, error) {
+			return s.marshalSupportResources(ctx, res.namespace, res.typ)
+		})
+	}
+
+	if bundle.errs.Len() > 0 {
+		if err = bundle.add("errors.txt", bundle.errs.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	if err = bundle.tw.Close(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// collect adds the file to the bundle, errors are recorded in the bundle instead of failing it.
+func (bundle *supportBundle) collect(name string, f func() ([]byte, error)) {
+	data, err := f()
+	if err != nil {
+		fmt.Fprintf(&bundle.errs, "%s: %s\n", name, err)
+
+		return
+	}
+
+	if err = bundle.add(name, bundle.redactor.Redact(data)); err != nil {
+		fmt.Fprintf(&bundle.errs, "%s: %s\n", name, err)
+	}
+}
+
+func (bundle *supportBundle) add(name string, data []byte) error {
+	if err := bundle.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0o644,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	_, err := bundle.tw.Write(data)
+
+	return err
+}
+
+func newSupportRedactor(cfg config.Provider) (*redact.Redactor, error) {
+	if cfg == nil {
+		return redact.New()
+	}
+
+	return redact.ForConfig(cfg)
+}
+
+func readDmesg(ctx context.Context) ([]byte, error) {
+	reader, err := kmsg.NewReader()
+	if err != nil {
+		return nil, fmt.Errorf("error opening /dev/kmsg reader: %w", err)
+	}
+	defer reader.Close() //nolint:errcheck
+
+	var buf bytes.Buffer
+
+	for packet := range reader.Scan(ctx) {
+		if packet.Err != nil {
+			return buf.Bytes(), packet.Err
+		}
+
+		msg := packet.Message
+		fmt.Fprintf(&buf, "%s: %7s: [%s]: %s\n", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func marshalSupportMessage(m proto.Message, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return protojson.MarshalOptions{Multiline: true}.Marshal(m)
+}
+
+func (s *Server) marshalSupportResources(ctx context.Context, namespace resource.Namespace, typ resource.Type) ([]byte, error) {
+	list, err := s.Controller.Runtime().State().V1Alpha2().Resources().List(ctx, resource.NewMetadata(namespace, typ, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+
+	for _, r := range list.Items {
+		if err = enc.Encode(struct {
+			ID   resource.ID `yaml:"id"`
+			Spec interface{} `yaml:"spec"`
+		}{
+			ID:   r.Metadata().ID(),
+			Spec: r.Spec(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
 	"github.com/talos-systems/talos/internal/app/trustd"
+	"github.com/talos-systems/talos/internal/app/wrapperd"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
@@ -281,6 +282,10 @@ func main() {
 	case "/trustd":
 		trustd.Main()
 
+		return
+	case "/wrapperd":
+		wrapperd.Main()
+
 		return
 	default:
 	}
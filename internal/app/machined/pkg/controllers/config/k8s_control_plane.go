@@ -149,6 +149,7 @@ func (ctrl *K8sControlPlaneController) manageAPIServerConfig(ctx context.Context
 			ServiceCIDR:          cfgProvider.Cluster().Network().ServiceCIDR(),
 			ExtraArgs:            cfgProvider.Cluster().APIServer().ExtraArgs(),
 			ExtraVolumes:         convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
+			DisableAggregator:    cfgProvider.Cluster().APIServer().DisableAggregator(),
 		})
 
 		return nil
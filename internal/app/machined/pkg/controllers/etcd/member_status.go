@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	etcdresource "github.com/talos-systems/talos/pkg/resources/etcd"
+	"github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+const (
+	memberStatusUpdateInterval = 30 * time.Second
+	memberStatusTimeout        = 5 * time.Second
+)
+
+// MemberStatusController reports the status of the etcd cluster members and the quorum.
+//
+// Status is queried via the local etcd member, so resources are produced only on the nodes running etcd.
+type MemberStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *MemberStatusController) Name() string {
+	return "etcd.MemberStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MemberStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.ToString("etcd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MemberStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcdresource.MemberType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: etcdresource.QuorumStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *MemberStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(memberStatusUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		touchedMembers := make(map[resource.ID]struct{})
+		touchedQuorum := make(map[resource.ID]struct{})
+
+		svc, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service status: %w", err)
+		}
+
+		if err == nil && svc.(*v1alpha1.Service).Running() {
+			var quorum etcdresource.QuorumStatusSpec
+
+			quorum, err = ctrl.updateMembers(ctx, r, touchedMembers)
+			if err != nil {
+				// etcd might be still starting up, report unhealthy quorum as the state is not known
+				logger.Warn("failed to query etcd members status", zap.Error(err))
+
+				touchedMembers = make(map[resource.ID]struct{})
+				quorum = etcdresource.QuorumStatusSpec{}
+			}
+
+			if err = r.Modify(ctx, etcdresource.NewQuorumStatus(etcdresource.NamespaceName, etcdresource.QuorumStatusID), func(r resource.Resource) error {
+				*r.(*etcdresource.QuorumStatus).TypedSpec() = quorum
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating quorum status: %w", err)
+			}
+
+			touchedQuorum[etcdresource.QuorumStatusID] = struct{}{}
+		}
+
+		if err = ctrl.cleanup(ctx, r, etcdresource.MemberType, touchedMembers); err != nil {
+			return err
+		}
+
+		if err = ctrl.cleanup(ctx, r, etcdresource.QuorumStatusType, touchedQuorum); err != nil {
+			return err
+		}
+	}
+}
+
+// updateMembers queries the status of each etcd cluster member and updates the member resources.
+func (ctrl *MemberStatusController) updateMembers(ctx context.Context, r controller.Runtime, touchedIDs map[resource.ID]struct{}) (etcdresource.QuorumStatusSpec, error) {
+	var quorum etcdresource.QuorumStatusSpec
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return quorum, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	listCtx, listCancel := context.WithTimeout(ctx, memberStatusTimeout)
+	defer listCancel()
+
+	resp, err := client.MemberList(listCtx)
+	if err != nil {
+		return quorum, fmt.Errorf("failed to list etcd members: %w", err)
+	}
+
+	quorum.Members = len(resp.Members)
+
+	for _, member := range resp.Members {
+		spec := etcdresource.MemberSpec{
+			Hostname:   member.GetName(),
+			PeerURLs:   member.GetPeerURLs(),
+			ClientURLs: member.GetClientURLs(),
+		}
+
+		for _, endpoint := range member.GetClientURLs() {
+			statusCtx, statusCancel := context.WithTimeout(ctx, memberStatusTimeout)
+
+			status, err := client.Status(statusCtx, endpoint)
+
+			statusCancel()
+
+			if err != nil {
+				continue
+			}
+
+			spec.Healthy = true
+			spec.Leader = status.Leader == member.GetID()
+			spec.DBSize = status.DbSize
+
+			break
+		}
+
+		if spec.Healthy {
+			quorum.HealthyMembers++
+		}
+
+		if spec.Leader {
+			quorum.Leader = spec.Hostname
+		}
+
+		id := strconv.FormatUint(member.GetID(), 16)
+
+		if err = r.Modify(ctx, etcdresource.NewMember(etcdresource.NamespaceName, id), func(r resource.Resource) error {
+			*r.(*etcdresource.Member).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return quorum, fmt.Errorf("error updating member status: %w", err)
+		}
+
+		touchedIDs[id] = struct{}{}
+	}
+
+	quorum.Healthy = quorum.Leader != "" && quorum.HealthyMembers > quorum.Members/2
+
+	return quorum, nil
+}
+
+func (ctrl *MemberStatusController) cleanup(ctx context.Context, r controller.Runtime, resourceType resource.Type, touchedIDs map[resource.ID]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(etcdresource.NamespaceName, resourceType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if res.Metadata().Owner() != ctrl.Name() {
+			continue
+		}
+
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up resources: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	talosnet "github.com/talos-systems/net"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+// AddressFilterController creates NodeAddressFilters based on machine configuration.
+type AddressFilterController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *AddressFilterController) Name() string {
+	return "k8s.AddressFilterController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *AddressFilterController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.ToString(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *AddressFilterController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NodeAddressFilterType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *AddressFilterController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider := cfg.(*config.MachineConfig).Config()
+
+			var k8sSubnets []netaddr.IPPrefix
+
+			for _, cidrList := range []string{cfgProvider.Cluster().Network().PodCIDR(), cfgProvider.Cluster().Network().ServiceCIDR()} {
+				var cidrs []netaddr.IPPrefix
+
+				cidrs, err = parseCIDRs(cidrList)
+				if err != nil {
+					return err
+				}
+
+				k8sSubnets = append(k8sSubnets, cidrs...)
+			}
+
+			if err = r.Modify(ctx, network.NewNodeAddressFilter(network.NamespaceName, k8s.NodeAddressFilterNoK8s), func(r resource.Resource) error {
+				spec := r.(*network.NodeAddressFilter).TypedSpec()
+
+				spec.IncludeSubnets = nil
+				spec.ExcludeSubnets = k8sSubnets
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating output resource: %w", err)
+			}
+
+			touchedIDs[k8s.NodeAddressFilterNoK8s] = struct{}{}
+
+			if err = r.Modify(ctx, network.NewNodeAddressFilter(network.NamespaceName, k8s.NodeAddressFilterOnlyK8s), func(r resource.Resource) error {
+				spec := r.(*network.NodeAddressFilter).TypedSpec()
+
+				spec.IncludeSubnets = k8sSubnets
+				spec.ExcludeSubnets = nil
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating output resource: %w", err)
+			}
+
+			touchedIDs[k8s.NodeAddressFilterOnlyK8s] = struct{}{}
+		}
+
+		// list filters for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressFilterType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up filters: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func parseCIDRs(cidrList string) ([]netaddr.IPPrefix, error) {
+	cidrs, err := talosnet.SplitCIDRs(cidrList)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CIDRs %q: %w", cidrList, err)
+	}
+
+	result := make([]netaddr.IPPrefix, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		prefix, ok := netaddr.FromStdIPNet(cidr)
+		if !ok {
+			return nil, fmt.Errorf("error converting CIDR %q", cidr)
+		}
+
+		result = append(result, prefix)
+	}
+
+	return result, nil
+}
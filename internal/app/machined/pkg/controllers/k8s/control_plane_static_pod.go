@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/talos-systems/talos/pkg/argsbuilder"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/resources/config"
 	"github.com/talos-systems/talos/pkg/resources/k8s"
@@ -136,6 +137,25 @@ func (ctrl *ControlPlaneStaticPodController) teardownAll(ctx context.Context, r
 	return nil
 }
 
+// buildArgs merges default args with extra args, extra args override the defaults.
+//
+// Args from the denyList can't be overridden, such extra args are dropped with a warning.
+func buildArgs(logger *zap.Logger, command string, builder, denyListArgs argsbuilder.Args, extraArgs map[string]string) []string {
+	allowedArgs := make(argsbuilder.Args, len(extraArgs))
+
+	for k, v := range extraArgs {
+		if denyListArgs.Contains(k) {
+			logger.Warn("ignoring extra arg", zap.String("command", command), zap.Error(argsbuilder.NewDenylistError(k)))
+
+			continue
+		}
+
+		allowedArgs[k] = v
+	}
+
+	return append([]string{command}, builder.Merge(allowedArgs).Merge(denyListArgs).Args()...)
+}
+
 func volumeMounts(volumes []config.K8sExtraVolume) []v1.VolumeMount {
 	result := make([]v1.VolumeMount, 0, len(volumes))
 
@@ -179,13 +199,6 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"--authorization-mode=Node,RBAC",
 		"--bind-address=0.0.0.0",
 		fmt.Sprintf("--client-ca-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "ca.crt")),
-		fmt.Sprintf("--requestheader-client-ca-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "aggregator-ca.crt")),
-		"--requestheader-allowed-names=front-proxy-client",
-		"--requestheader-extra-headers-prefix=X-Remote-Extra-",
-		"--requestheader-group-headers=X-Remote-Group",
-		"--requestheader-username-headers=X-Remote-User",
-		fmt.Sprintf("--proxy-client-cert-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "front-proxy-client.crt")),
-		fmt.Sprintf("--proxy-client-key-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "front-proxy-client.key")),
 		"--enable-bootstrap-token-auth=true",
 		"--tls-cipher-suites=TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_128_GCM_SHA256", //nolint:lll
 		fmt.Sprintf("--encryption-provider-config=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "encryptionconfig.yaml")),
@@ -212,6 +225,18 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"--kubelet-preferred-address-types=InternalIP,ExternalIP,Hostname",
 	}
 
+	if !cfg.DisableAggregator {
+		args = append(args,
+			fmt.Sprintf("--requestheader-client-ca-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "aggregator-ca.crt")),
+			"--requestheader-allowed-names=front-proxy-client",
+			"--requestheader-extra-headers-prefix=X-Remote-Extra-",
+			"--requestheader-group-headers=X-Remote-Group",
+			"--requestheader-username-headers=X-Remote-User",
+			fmt.Sprintf("--proxy-client-cert-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "front-proxy-client.crt")),
+			fmt.Sprintf("--proxy-client-key-file=%s", filepath.Join(constants.KubernetesAPIServerSecretsDir, "front-proxy-client.key")),
+		)
+	}
+
 	if cfg.CloudProvider != "" {
 		args = append(args, fmt.Sprintf("--cloud-provider=%s", cfg.CloudProvider))
 	}
@@ -296,34 +321,35 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 	logger *zap.Logger, configResource *config.K8sControlPlane, secretsVersion string) error {
 	cfg := configResource.ControllerManager()
 
-	args := []string{
-		"/usr/local/bin/kube-controller-manager",
-		"--allocate-node-cidrs=true",
-		"--bind-address=127.0.0.1",
-		"--port=0",
-		fmt.Sprintf("--cluster-cidr=%s", cfg.PodCIDR),
-		fmt.Sprintf("--service-cluster-ip-range=%s", cfg.ServiceCIDR),
-		fmt.Sprintf("--cluster-signing-cert-file=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.crt")),
-		fmt.Sprintf("--cluster-signing-key-file=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.key")),
-		"--configure-cloud-routes=false",
-		fmt.Sprintf("--kubeconfig=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig")),
-		fmt.Sprintf("--authentication-kubeconfig=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig")),
-		fmt.Sprintf("--authorization-kubeconfig=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig")),
-		"--leader-elect=true",
-		fmt.Sprintf("--root-ca-file=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.crt")),
-		fmt.Sprintf("--service-account-private-key-file=%s", filepath.Join(constants.KubernetesControllerManagerSecretsDir, "service-account.key")),
-		"--profiling=false",
-		"--use-service-account-credentials",
+	builder := argsbuilder.Args{
+		"allocate-node-cidrs":             "true",
+		"bind-address":                    "127.0.0.1",
+		"port":                            "0",
+		"cluster-cidr":                    cfg.PodCIDR,
+		"service-cluster-ip-range":        cfg.ServiceCIDR,
+		"configure-cloud-routes":          "false",
+		"leader-elect":                    "true",
+		"profiling":                       "false",
+		"use-service-account-credentials": "true",
 	}
 
 	if cfg.CloudProvider != "" {
-		args = append(args, fmt.Sprintf("--cloud-provider=%s", cfg.CloudProvider))
+		builder.Set("cloud-provider", cfg.CloudProvider)
 	}
 
-	for k, v := range cfg.ExtraArgs {
-		args = append(args, fmt.Sprintf("--%s=%s", k, v))
+	// these args point to the secrets managed by Talos, so they can't be overridden
+	denyListArgs := argsbuilder.Args{
+		"cluster-signing-cert-file":        filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.crt"),
+		"cluster-signing-key-file":         filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.key"),
+		"kubeconfig":                       filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig"),
+		"authentication-kubeconfig":        filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig"),
+		"authorization-kubeconfig":         filepath.Join(constants.KubernetesControllerManagerSecretsDir, "kubeconfig"),
+		"root-ca-file":                     filepath.Join(constants.KubernetesControllerManagerSecretsDir, "ca.crt"),
+		"service-account-private-key-file": filepath.Join(constants.KubernetesControllerManagerSecretsDir, "service-account.key"),
 	}
 
+	args := buildArgs(logger, "/usr/local/bin/kube-controller-manager", builder, denyListArgs, cfg.ExtraArgs)
+
 	//nolint:dupl
 	return r.Modify(ctx, k8s.NewStaticPod(k8s.ControlPlaneNamespaceName, "kube-controller-manager", nil), func(r resource.Resource) error {
 		r.(*k8s.StaticPod).SetPod(&v1.Pod{
@@ -403,22 +429,23 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 	logger *zap.Logger, configResource *config.K8sControlPlane, secretsVersion string) error {
 	cfg := configResource.Scheduler()
 
-	args := []string{
-		"/usr/local/bin/kube-scheduler",
-		fmt.Sprintf("--kubeconfig=%s", filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig")),
-		"--authentication-tolerate-lookup-failure=false",
-		fmt.Sprintf("--authentication-kubeconfig=%s", filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig")),
-		fmt.Sprintf("--authorization-kubeconfig=%s", filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig")),
-		"--bind-address=127.0.0.1",
-		"--port=0",
-		"--leader-elect=true",
-		"--profiling=false",
+	builder := argsbuilder.Args{
+		"authentication-tolerate-lookup-failure": "false",
+		"bind-address":                           "127.0.0.1",
+		"port":                                   "0",
+		"leader-elect":                           "true",
+		"profiling":                              "false",
 	}
 
-	for k, v := range cfg.ExtraArgs {
-		args = append(args, fmt.Sprintf("--%s=%s", k, v))
+	// these args point to the secrets managed by Talos, so they can't be overridden
+	denyListArgs := argsbuilder.Args{
+		"kubeconfig":                filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig"),
+		"authentication-kubeconfig": filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig"),
+		"authorization-kubeconfig":  filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig"),
 	}
 
+	args := buildArgs(logger, "/usr/local/bin/kube-scheduler", builder, denyListArgs, cfg.ExtraArgs)
+
 	//nolint:dupl
 	return r.Modify(ctx, k8s.NewStaticPod(k8s.ControlPlaneNamespaceName, "kube-scheduler", nil), func(r resource.Resource) error {
 		r.(*k8s.StaticPod).SetPod(&v1.Pod{
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
@@ -184,6 +185,94 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraMounts() {
 	}, apiServerPod.Spec.Containers[0].VolumeMounts[1])
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraArgs() {
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := config.NewK8sControlPlaneAPIServer()
+
+	configControllerManager := config.NewK8sControlPlaneControllerManager()
+	configControllerManager.SetControllerManager(config.K8sControlPlaneControllerManagerSpec{
+		ExtraArgs: map[string]string{
+			"bind-address": "0.0.0.0",
+		},
+	})
+
+	configScheduler := config.NewK8sControlPlaneScheduler()
+	configScheduler.SetScheduler(config.K8sControlPlaneSchedulerSpec{
+		ExtraArgs: map[string]string{
+			"bind-address": "0.0.0.0",
+			"kubeconfig":   "/tmp/kubeconfig",
+			"v":            "2",
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configControllerManager))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configScheduler))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertControlPlaneStaticPods(
+				[]string{
+					"kube-apiserver",
+					"kube-controller-manager",
+					"kube-scheduler",
+				},
+			)
+		},
+	))
+
+	for id, secretsDir := range map[string]string{
+		"kube-controller-manager": constants.KubernetesControllerManagerSecretsDir,
+		"kube-scheduler":          constants.KubernetesSchedulerSecretsDir,
+	} {
+		r, err := suite.state.Get(suite.ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.StaticPodType, id, resource.VersionUndefined))
+		suite.Require().NoError(err)
+
+		command := r.(*k8s.StaticPod).Pod().Spec.Containers[0].Command
+
+		suite.Assert().Contains(command, "--bind-address=0.0.0.0")
+		suite.Assert().NotContains(command, "--bind-address=127.0.0.1")
+		suite.Assert().Contains(command, "--kubeconfig="+filepath.Join(secretsDir, "kubeconfig"))
+		suite.Assert().NotContains(command, "--kubeconfig=/tmp/kubeconfig")
+	}
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileDisableAggregator() {
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := config.NewK8sControlPlaneAPIServer()
+	configAPIServer.SetAPIServer(config.K8sControlPlaneAPIServerSpec{
+		DisableAggregator: true,
+	})
+	configControllerManager := config.NewK8sControlPlaneControllerManager()
+	configScheduler := config.NewK8sControlPlaneScheduler()
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configControllerManager))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configScheduler))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertControlPlaneStaticPods(
+				[]string{
+					"kube-apiserver",
+					"kube-controller-manager",
+					"kube-scheduler",
+				},
+			)
+		},
+	))
+
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined))
+	suite.Require().NoError(err)
+
+	for _, arg := range r.(*k8s.StaticPod).Pod().Spec.Containers[0].Command {
+		suite.Assert().NotContains(arg, "--requestheader-")
+		suite.Assert().NotContains(arg, "--proxy-client-")
+	}
+}
+
 func (suite *ControlPlaneStaticPodSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/procfs"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+)
+
+// NodeCapacity describes memory and CPU capacity of the node.
+type NodeCapacity struct {
+	// Memory is total memory in bytes.
+	Memory uint64
+	// CPUs is the number of CPUs.
+	CPUs int
+}
+
+// KubeletReservedController computes resources reserved for the system and Kubernetes daemons.
+type KubeletReservedController struct {
+	// Capacity returns node capacity, if not set it is read from /proc/meminfo and CPU count.
+	Capacity func() (NodeCapacity, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *KubeletReservedController) Name() string {
+	return "k8s.KubeletReservedController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KubeletReservedController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.ToString(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KubeletReservedController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.KubeletReservedType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *KubeletReservedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	capacityFunc := ctrl.Capacity
+	if capacityFunc == nil {
+		capacityFunc = readNodeCapacity
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		var systemReserved, kubeReserved map[string]string
+
+		policy := cfg.(*config.MachineConfig).Config().Machine().Kubelet().Reserved().Policy()
+
+		switch policy {
+		case constants.KubeletReservedPolicyNone:
+		case constants.KubeletReservedPolicyAuto:
+			var capacity NodeCapacity
+
+			capacity, err = capacityFunc()
+			if err != nil {
+				return fmt.Errorf("error reading node capacity: %w", err)
+			}
+
+			systemReserved, kubeReserved = computeReserved(capacity)
+
+			logger.Debug("computed reserved resources", zap.Any("system", systemReserved), zap.Any("kube", kubeReserved))
+		default:
+			return fmt.Errorf("unsupported reserved resources policy %q", policy)
+		}
+
+		if err = r.Modify(
+			ctx,
+			k8s.NewKubeletReserved(k8s.ControlPlaneNamespaceName, k8s.KubeletID),
+			func(r resource.Resource) error {
+				r.(*k8s.KubeletReserved).TypedSpec().SystemReserved = systemReserved
+				r.(*k8s.KubeletReserved).TypedSpec().KubeReserved = kubeReserved
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("error modifying KubeletReserved resource: %w", err)
+		}
+	}
+}
+
+func readNodeCapacity() (NodeCapacity, error) {
+	fs, err := procfs.NewDefaultFS()
+	if err != nil {
+		return NodeCapacity{}, err
+	}
+
+	meminfo, err := fs.Meminfo()
+	if err != nil {
+		return NodeCapacity{}, err
+	}
+
+	if meminfo.MemTotal == nil {
+		return NodeCapacity{}, fmt.Errorf("total memory is not reported in /proc/meminfo")
+	}
+
+	return NodeCapacity{
+		Memory: *meminfo.MemTotal * 1024,
+		CPUs:   runtime.NumCPU(),
+	}, nil
+}
+
+const mebibyte = 1024 * 1024
+
+// computeReserved computes `--system-reserved` and `--kube-reserved` for the node capacity.
+//
+// Kubernetes daemons reservation follows the tiered model used by the major cloud providers:
+//
+//   - memory: 255Mi on nodes with less than 1Gi, otherwise 25% of the first 4Gi, 20% of the next 4Gi,
+//     10% of the next 8Gi, 6% of the next 112Gi and 2% of the rest;
+//   - CPU: 6% of the first core, 1% of the next core, 0.5% of the next 2 cores and 0.25% of the rest.
+//
+// System daemons (containerd, etcd, apid, etc.) get 5% of the memory (at least 128Mi, at most 1Gi) and 100m CPU.
+func computeReserved(capacity NodeCapacity) (systemReserved, kubeReserved map[string]string) {
+	systemMemory := capacity.Memory / 20
+
+	if systemMemory < 128*mebibyte {
+		systemMemory = 128 * mebibyte
+	}
+
+	if systemMemory > 1024*mebibyte {
+		systemMemory = 1024 * mebibyte
+	}
+
+	systemReserved = map[string]string{
+		"cpu":    "100m",
+		"memory": fmt.Sprintf("%dMi", systemMemory/mebibyte),
+	}
+
+	kubeReserved = map[string]string{
+		"cpu":    fmt.Sprintf("%dm", kubeReservedMilliCPU(capacity.CPUs)),
+		"memory": fmt.Sprintf("%dMi", kubeReservedMemory(capacity.Memory)/mebibyte),
+	}
+
+	return systemReserved, kubeReserved
+}
+
+type tier struct {
+	size     uint64
+	fraction uint64 // in 1/10000
+}
+
+func applyTiers(amount uint64, tiers []tier) uint64 {
+	var result uint64
+
+	for _, t := range tiers {
+		if amount == 0 {
+			break
+		}
+
+		portion := amount
+		if t.size > 0 && portion > t.size {
+			portion = t.size
+		}
+
+		result += portion * t.fraction / 10000
+		amount -= portion
+	}
+
+	return result
+}
+
+func kubeReservedMemory(memory uint64) uint64 {
+	if memory < 1024*mebibyte {
+		return 255 * mebibyte
+	}
+
+	return applyTiers(memory, []tier{
+		{4 * 1024 * mebibyte, 2500},
+		{4 * 1024 * mebibyte, 2000},
+		{8 * 1024 * mebibyte, 1000},
+		{112 * 1024 * mebibyte, 600},
+		{0, 200},
+	})
+}
+
+func kubeReservedMilliCPU(cpus int) uint64 {
+	return applyTiers(uint64(cpus)*1000, []tier{
+		{1000, 600},
+		{1000, 100},
+		{2000, 50},
+		{0, 25},
+	})
+}
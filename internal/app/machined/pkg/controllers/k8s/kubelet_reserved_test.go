@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+)
+
+type KubeletReservedSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	capacityMu sync.Mutex
+	capacity   k8sctrl.NodeCapacity
+}
+
+func (suite *KubeletReservedSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.KubeletReservedController{
+		Capacity: func() (k8sctrl.NodeCapacity, error) {
+			suite.capacityMu.Lock()
+			defer suite.capacityMu.Unlock()
+
+			return suite.capacity, nil
+		},
+	}))
+
+	suite.startRuntime()
+}
+
+func (suite *KubeletReservedSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *KubeletReservedSuite) assertReserved(expectedSystem, expectedKube map[string]string) error {
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.KubeletReservedType, k8s.KubeletID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		return err
+	}
+
+	spec := r.(*k8s.KubeletReserved).TypedSpec()
+
+	if !reflect.DeepEqual(expectedSystem, spec.SystemReserved) {
+		return retry.ExpectedError(fmt.Errorf("expected system reserved %v, got %v", expectedSystem, spec.SystemReserved))
+	}
+
+	if !reflect.DeepEqual(expectedKube, spec.KubeReserved) {
+		return retry.ExpectedError(fmt.Errorf("expected kube reserved %v, got %v", expectedKube, spec.KubeReserved))
+	}
+
+	return nil
+}
+
+func (suite *KubeletReservedSuite) createConfig(kubelet *v1alpha1.KubeletConfig) {
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: kubelet,
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+}
+
+func (suite *KubeletReservedSuite) TestNone() {
+	suite.createConfig(nil)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertReserved(nil, nil)
+		},
+	))
+}
+
+func (suite *KubeletReservedSuite) TestAuto() {
+	for _, tt := range []struct {
+		capacity       k8sctrl.NodeCapacity
+		expectedSystem map[string]string
+		expectedKube   map[string]string
+	}{
+		{
+			capacity: k8sctrl.NodeCapacity{
+				Memory: 512 * 1024 * 1024,
+				CPUs:   1,
+			},
+			expectedSystem: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			expectedKube:   map[string]string{"cpu": "60m", "memory": "255Mi"},
+		},
+		{
+			capacity: k8sctrl.NodeCapacity{
+				Memory: 4 * 1024 * 1024 * 1024,
+				CPUs:   2,
+			},
+			expectedSystem: map[string]string{"cpu": "100m", "memory": "204Mi"},
+			expectedKube:   map[string]string{"cpu": "70m", "memory": "1024Mi"},
+		},
+		{
+			capacity: k8sctrl.NodeCapacity{
+				Memory: 16 * 1024 * 1024 * 1024,
+				CPUs:   8,
+			},
+			expectedSystem: map[string]string{"cpu": "100m", "memory": "819Mi"},
+			expectedKube:   map[string]string{"cpu": "90m", "memory": "2662Mi"},
+		},
+		{
+			capacity: k8sctrl.NodeCapacity{
+				Memory: 256 * 1024 * 1024 * 1024,
+				CPUs:   64,
+			},
+			expectedSystem: map[string]string{"cpu": "100m", "memory": "1024Mi"},
+			expectedKube:   map[string]string{"cpu": "230m", "memory": "12165Mi"},
+		},
+	} {
+		suite.capacityMu.Lock()
+		suite.capacity = tt.capacity
+		suite.capacityMu.Unlock()
+
+		suite.createConfig(&v1alpha1.KubeletConfig{
+			KubeletReserved: v1alpha1.KubeletReservedConfig{
+				KubeletReservedPolicy: "auto",
+			},
+		})
+
+		suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertReserved(tt.expectedSystem, tt.expectedKube)
+			},
+		))
+
+		suite.Require().NoError(suite.state.Destroy(suite.ctx, config.NewMachineConfig(nil).Metadata()))
+	}
+}
+
+func (suite *KubeletReservedSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	err := suite.state.Create(context.Background(), config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	}))
+	if state.IsConflictError(err) {
+		err = suite.state.Destroy(context.Background(), config.NewMachineConfig(nil).Metadata())
+	}
+
+	suite.Require().NoError(err)
+}
+
+func TestKubeletReservedSuite(t *testing.T) {
+	suite.Run(t, new(KubeletReservedSuite))
+}
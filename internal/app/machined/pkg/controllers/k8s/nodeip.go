@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	talosnet "github.com/talos-systems/net"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+// NodeIPController picks the kubelet node IP from the node addresses based on machine configuration.
+type NodeIPController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeIPController) Name() string {
+	return "k8s.NodeIPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeIPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.ToString(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        pointer.ToString(network.FilteredNodeAddressID(network.NodeAddressCurrentID, k8s.NodeAddressFilterNoK8s)),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeIPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeIPType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NodeIPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		cfgProvider := cfg.(*config.MachineConfig).Config()
+
+		validSubnets, err := ctrl.validSubnets(cfgProvider)
+		if err != nil {
+			return fmt.Errorf("error building valid subnets: %w", err)
+		}
+
+		excludeSubnets := ctrl.excludeSubnets(cfgProvider)
+
+		nodeAddrs, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.FilteredNodeAddressID(network.NodeAddressCurrentID, k8s.NodeAddressFilterNoK8s), resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting addresses: %w", err)
+		}
+
+		ips := pickNodeIPs(nodeAddrs.(*network.NodeAddress).TypedSpec().Addresses, validSubnets, excludeSubnets)
+
+		if len(ips) == 0 {
+			logger.Warn("no suitable node IP found, please make sure .machine.kubelet.nodeIP filters and pod/service subnets are set up correctly")
+
+			continue
+		}
+
+		if err = r.Modify(
+			ctx,
+			k8s.NewNodeIP(k8s.ControlPlaneNamespaceName, k8s.KubeletID),
+			func(r resource.Resource) error {
+				r.(*k8s.NodeIP).TypedSpec().Addresses = ips
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("error modifying NodeIP resource: %w", err)
+		}
+	}
+}
+
+func (ctrl *NodeIPController) validSubnets(cfgProvider talosconfig.Provider) ([]netaddr.IPPrefix, error) {
+	subnets := cfgProvider.Machine().Kubelet().NodeIP().ValidSubnets()
+
+	if len(subnets) == 0 {
+		// pick any address of the same address family as the service subnets
+		serviceCIDRs, err := talosnet.SplitCIDRs(cfgProvider.Cluster().Network().ServiceCIDR())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing service CIDRs: %w", err)
+		}
+
+		for _, cidr := range serviceCIDRs {
+			if cidr.IP.To4() != nil {
+				subnets = append(subnets, "0.0.0.0/0")
+			} else {
+				subnets = append(subnets, "::/0")
+			}
+		}
+	}
+
+	result := make([]netaddr.IPPrefix, 0, len(subnets))
+
+	for _, subnet := range subnets {
+		prefix, err := netaddr.ParseIPPrefix(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing subnet %q: %w", subnet, err)
+		}
+
+		result = append(result, prefix)
+	}
+
+	return result, nil
+}
+
+func (ctrl *NodeIPController) excludeSubnets(cfgProvider talosconfig.Provider) []netaddr.IPPrefix {
+	var result []netaddr.IPPrefix
+
+	// pod and service IPs are filtered out by the no-k8s address filter,
+	// shared (virtual) IPs move between the nodes, so they can't be node IPs either
+	for _, device := range cfgProvider.Machine().Network().Devices() {
+		if device.VIPConfig() == nil {
+			continue
+		}
+
+		ip, ok := netaddr.FromStdIP(net.ParseIP(device.VIPConfig().IP()))
+		if !ok {
+			continue
+		}
+
+		result = append(result, netaddr.IPPrefixFrom(ip, ip.BitLen()))
+	}
+
+	return result
+}
+
+// pickNodeIPs picks at most one address per valid subnet, and at most one address per address family.
+func pickNodeIPs(addresses []netaddr.IP, validSubnets, excludeSubnets []netaddr.IPPrefix) []netaddr.IP {
+	var ips []netaddr.IP
+
+	hasFamily := func(ip netaddr.IP) bool {
+		for _, picked := range ips {
+			if picked.Is4() == ip.Is4() {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	excluded := func(ip netaddr.IP) bool {
+		for _, subnet := range excludeSubnets {
+			if subnet.Contains(ip) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, subnet := range validSubnets {
+		for _, ip := range addresses {
+			if !subnet.Contains(ip) || excluded(ip) || hasFamily(ip) {
+				continue
+			}
+
+			ips = append(ips, ip)
+
+			break
+		}
+	}
+
+	return ips
+}
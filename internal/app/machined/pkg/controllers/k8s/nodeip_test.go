@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+type NodeIPSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+func (suite *NodeIPSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.NodeIPController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *NodeIPSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *NodeIPSuite) assertNodeIP(expected []string) error {
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.NodeIPType, k8s.KubeletID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		return err
+	}
+
+	addresses := r.(*k8s.NodeIP).TypedSpec().Addresses
+	actual := make([]string, len(addresses))
+
+	for i := range addresses {
+		actual[i] = addresses[i].String()
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		return retry.ExpectedError(fmt.Errorf("expected %v, got %v", expected, actual))
+	}
+
+	return nil
+}
+
+func (suite *NodeIPSuite) createConfig(kubelet *v1alpha1.KubeletConfig, serviceSubnets []string) {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: kubelet,
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+			ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+				PodSubnet:     []string{"10.244.0.0/16", "fc00:db8:10::/56"},
+				ServiceSubnet: serviceSubnets,
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+}
+
+func (suite *NodeIPSuite) createAddresses(addresses ...string) {
+	nodeAddress := network.NewNodeAddress(network.NamespaceName, network.FilteredNodeAddressID(network.NodeAddressCurrentID, k8s.NodeAddressFilterNoK8s))
+
+	for _, addr := range addresses {
+		nodeAddress.TypedSpec().Addresses = append(nodeAddress.TypedSpec().Addresses, netaddr.MustParseIP(addr))
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeAddress))
+}
+
+func (suite *NodeIPSuite) TestDefault() {
+	suite.createConfig(nil, []string{"10.96.0.0/12"})
+	suite.createAddresses("10.0.0.5", "172.20.0.2", "2001:db8::1")
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertNodeIP([]string{"10.0.0.5"})
+		},
+	))
+}
+
+func (suite *NodeIPSuite) TestValidSubnets() {
+	suite.createConfig(&v1alpha1.KubeletConfig{
+		KubeletNodeIP: v1alpha1.KubeletNodeIPConfig{
+			KubeletNodeIPValidSubnets: []string{"172.20.0.0/16", "2001:db8::/32"},
+		},
+	}, []string{"10.96.0.0/12"})
+	suite.createAddresses("10.0.0.5", "172.20.0.2", "172.20.0.3", "2001:db8::1")
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertNodeIP([]string{"172.20.0.2", "2001:db8::1"})
+		},
+	))
+}
+
+func (suite *NodeIPSuite) TestDualStackDefault() {
+	suite.createConfig(nil, []string{"10.96.0.0/12", "fd00:10:96::/112"})
+	suite.createAddresses("172.20.0.2", "2001:db8::1")
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertNodeIP([]string{"172.20.0.2", "2001:db8::1"})
+		},
+	))
+}
+
+func (suite *NodeIPSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	err := suite.state.Create(context.Background(), config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	}))
+	if state.IsConflictError(err) {
+		err = suite.state.Destroy(context.Background(), config.NewMachineConfig(nil).Metadata())
+	}
+
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewNodeAddress(network.NamespaceName, "bar")))
+}
+
+func TestNodeIPSuite(t *testing.T) {
+	suite.Run(t, new(NodeIPSuite))
+}
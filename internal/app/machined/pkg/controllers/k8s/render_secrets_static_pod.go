@@ -123,6 +123,21 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 			return fmt.Errorf("error parsing service account key: %w", err)
 		}
 
+		// public keys of the additional service account keys are appended to the public key file,
+		// so that tokens signed with the previous keys are still accepted during the key rotation
+		serviceAccountPublicKeys := append([]byte(nil), serviceAccountKey.GetPublicKeyPEM()...)
+
+		for i, additionalKey := range rootK8sSecrets.ServiceAccountAdditionalKeys {
+			var key x509.Key
+
+			key, err = additionalKey.GetKey()
+			if err != nil {
+				return fmt.Errorf("error parsing service account additional key %d: %w", i, err)
+			}
+
+			serviceAccountPublicKeys = append(serviceAccountPublicKeys, key.GetPublicKeyPEM()...)
+		}
+
 		type secret struct {
 			getter       func() *x509.PEMEncodedCertificateAndKey
 			certFilename string
@@ -170,7 +185,7 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 					{
 						getter: func() *x509.PEMEncodedCertificateAndKey {
 							return &x509.PEMEncodedCertificateAndKey{
-								Crt: serviceAccountKey.GetPublicKeyPEM(),
+								Crt: serviceAccountPublicKeys,
 								Key: serviceAccountKey.GetPrivateKeyPEM(),
 							}
 						},
@@ -210,7 +225,7 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 					{
 						getter: func() *x509.PEMEncodedCertificateAndKey {
 							return &x509.PEMEncodedCertificateAndKey{
-								Crt: serviceAccountKey.GetPublicKeyPEM(),
+								Crt: serviceAccountPublicKeys,
 								Key: serviceAccountKey.GetPrivateKeyPEM(),
 							}
 						},
@@ -242,6 +257,11 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 			for _, secret := range pod.secrets {
 				certAndKey := secret.getter()
 
+				// optional secrets (e.g. front-proxy when the aggregation layer is disabled) are not rendered
+				if certAndKey == nil {
+					continue
+				}
+
 				if secret.certFilename != "" {
 					if err = ioutil.WriteFile(filepath.Join(pod.directory, secret.certFilename), certAndKey.Crt, 0o400); err != nil {
 						return fmt.Errorf("error writing certificate %q for %q: %w", secret.certFilename, pod.name, err)
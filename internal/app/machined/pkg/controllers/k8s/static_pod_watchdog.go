@@ -0,0 +1,258 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+
+	"github.com/talos-systems/talos/internal/pkg/cri"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+)
+
+// RestartPodFunc restarts the pod with the specified namespace and name.
+type RestartPodFunc func(ctx context.Context, namespace, name string) error
+
+// StaticPodWatchdogController restarts control plane static pods which stay unhealthy for too long.
+//
+// Pods which have never been ready are not restarted, so that a slow first start (e.g. on cluster bootstrap) is not interrupted.
+//
+// Watchdog is configured in the machine configuration, it is disabled until the configuration is loaded.
+type StaticPodWatchdogController struct {
+	// RestartPod defaults to stopping the pod sandbox via CRI, so that the kubelet starts the pod again.
+	RestartPod RestartPodFunc
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *StaticPodWatchdogController) Name() string {
+	return "k8s.StaticPodWatchdogController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *StaticPodWatchdogController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.StaticPodStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.ToString(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *StaticPodWatchdogController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *StaticPodWatchdogController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.RestartPod == nil {
+		ctrl.RestartPod = stopPodSandbox
+	}
+
+	var (
+		ticker    *time.Ticker
+		tickerCh  <-chan time.Time
+		threshold time.Duration
+	)
+
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
+	unhealthySince := map[resource.ID]time.Time{}
+	everReady := map[resource.ID]struct{}{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-tickerCh:
+		}
+
+		var watchdog talosconfig.StaticPodWatchdog
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			watchdog = cfg.(*config.MachineConfig).Config().Cluster().StaticPodWatchdog()
+		}
+
+		if watchdog == nil || !watchdog.Enabled() {
+			if ticker != nil {
+				ticker.Stop()
+
+				ticker, tickerCh = nil, nil
+			}
+
+			unhealthySince = map[resource.ID]time.Time{}
+			everReady = map[resource.ID]struct{}{}
+
+			continue
+		}
+
+		if ticker == nil || watchdog.UnhealthyThreshold() != threshold {
+			threshold = watchdog.UnhealthyThreshold()
+
+			if ticker != nil {
+				ticker.Stop()
+			}
+
+			// pod status might not change while the pod is unhealthy, so re-check it periodically
+			ticker = time.NewTicker(threshold / 10)
+			tickerCh = ticker.C
+		}
+
+		statuses, err := r.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.StaticPodStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing pod statuses: %w", err)
+		}
+
+		now := time.Now()
+		seen := map[resource.ID]struct{}{}
+
+		for _, status := range statuses.Items {
+			id := status.Metadata().ID()
+
+			namespace, name := splitStaticPodStatusID(id)
+			if !isControlPlaneStaticPod(namespace, name) {
+				continue
+			}
+
+			seen[id] = struct{}{}
+
+			if k8s.PodReady(status.(*k8s.StaticPodStatus).Status()) {
+				delete(unhealthySince, id)
+
+				everReady[id] = struct{}{}
+
+				continue
+			}
+
+			if _, ok := everReady[id]; !ok {
+				continue
+			}
+
+			since, ok := unhealthySince[id]
+			if !ok {
+				unhealthySince[id] = now
+
+				continue
+			}
+
+			if now.Sub(since) < threshold {
+				continue
+			}
+
+			logger.Warn("restarting unhealthy static pod", zap.String("pod", id), zap.Duration("unhealthy_for", now.Sub(since)))
+
+			if err = ctrl.RestartPod(ctx, namespace, name); err != nil {
+				logger.Error("error restarting static pod", zap.String("pod", id), zap.Error(err))
+			}
+
+			// give the restarted pod another full threshold to become healthy
+			unhealthySince[id] = now
+		}
+
+		for id := range unhealthySince {
+			if _, ok := seen[id]; !ok {
+				delete(unhealthySince, id)
+			}
+		}
+
+		for id := range everReady {
+			if _, ok := seen[id]; !ok {
+				delete(everReady, id)
+			}
+		}
+	}
+}
+
+// splitStaticPodStatusID splits the k8s.StaticPodStatus ID into pod namespace and name.
+func splitStaticPodStatusID(id resource.ID) (namespace, name string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", id
+	}
+
+	return parts[0], parts[1]
+}
+
+// isControlPlaneStaticPod checks whether the pod is a control plane mirror pod.
+func isControlPlaneStaticPod(namespace, name string) bool {
+	if namespace != "kube-system" {
+		return false
+	}
+
+	for _, app := range k8s.ControlPlaneStaticPods {
+		if k8s.IsMirrorPodOf(name, app) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stopPodSandbox stops the pod sandbox, the containers get SIGTERM and the kubelet starts the pod again.
+func stopPodSandbox(ctx context.Context, namespace, name string) error {
+	client, err := cri.NewClient("unix://"+constants.CRIContainerdAddress, 10*time.Second)
+	if err != nil {
+		return fmt.Errorf("error creating CRI client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	sandboxes, err := client.ListPodSandbox(ctx, &runtimeapi.PodSandboxFilter{
+		State: &runtimeapi.PodSandboxStateValue{
+			State: runtimeapi.PodSandboxState_SANDBOX_READY,
+		},
+		LabelSelector: map[string]string{
+			"io.kubernetes.pod.namespace": namespace,
+			"io.kubernetes.pod.name":      name,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(sandboxes) == 0 {
+		return fmt.Errorf("no running sandbox found for pod %s/%s", namespace, name)
+	}
+
+	for _, sandbox := range sandboxes {
+		if err = client.StopPodSandbox(ctx, sandbox.GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
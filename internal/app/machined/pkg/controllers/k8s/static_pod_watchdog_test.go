@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	v1 "k8s.io/api/core/v1"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+)
+
+type StaticPodWatchdogSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	restartedMu sync.Mutex
+	restarted   []string
+}
+
+func (suite *StaticPodWatchdogSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.restarted = nil
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.StaticPodWatchdogController{
+		RestartPod: func(ctx context.Context, namespace, name string) error {
+			suite.restartedMu.Lock()
+			defer suite.restartedMu.Unlock()
+
+			suite.restarted = append(suite.restarted, namespace+"/"+name)
+
+			return nil
+		},
+	}))
+
+	suite.startRuntime()
+}
+
+func (suite *StaticPodWatchdogSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *StaticPodWatchdogSuite) createConfig(watchdog *v1alpha1.StaticPodWatchdogConfig) {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+			StaticPodWatchdogConfig: watchdog,
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+}
+
+func podStatus(ready v1.ConditionStatus) *v1.PodStatus {
+	return &v1.PodStatus{
+		Phase: v1.PodRunning,
+		Conditions: []v1.PodCondition{
+			{
+				Type:   v1.PodReady,
+				Status: ready,
+			},
+		},
+	}
+}
+
+func (suite *StaticPodWatchdogSuite) createPodStatus(id string, ready v1.ConditionStatus) {
+	status := k8s.NewStaticPodStatus(k8s.ControlPlaneNamespaceName, id)
+	status.SetStatus(podStatus(ready))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, status))
+}
+
+func (suite *StaticPodWatchdogSuite) updatePodStatus(id string, ready v1.ConditionStatus) {
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, k8s.NewStaticPodStatus(k8s.ControlPlaneNamespaceName, id).Metadata(), func(r resource.Resource) error {
+		r.(*k8s.StaticPodStatus).SetStatus(podStatus(ready))
+
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+// createFailedPodStatus creates a pod status which was ready before and then became not ready.
+func (suite *StaticPodWatchdogSuite) createFailedPodStatus(id string) {
+	suite.createPodStatus(id, v1.ConditionTrue)
+
+	// let the controller observe the pod being ready
+	time.Sleep(200 * time.Millisecond)
+
+	suite.updatePodStatus(id, v1.ConditionFalse)
+}
+
+func (suite *StaticPodWatchdogSuite) assertRestarted(expected []string) error {
+	suite.restartedMu.Lock()
+	defer suite.restartedMu.Unlock()
+
+	if !reflect.DeepEqual(expected, suite.restarted) {
+		return retry.ExpectedErrorf("expected restarted pods %q, got %q", expected, suite.restarted)
+	}
+
+	return nil
+}
+
+func (suite *StaticPodWatchdogSuite) TestRestartUnhealthy() {
+	suite.createConfig(&v1alpha1.StaticPodWatchdogConfig{
+		WatchdogUnhealthyThreshold: time.Second,
+	})
+
+	suite.createFailedPodStatus("kube-system/kube-apiserver-node1")
+	suite.createPodStatus("kube-system/kube-scheduler-node1", v1.ConditionTrue)
+	suite.createFailedPodStatus("kube-system/custom-pod-node1")
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertRestarted([]string{"kube-system/kube-apiserver-node1"})
+		},
+	))
+}
+
+func (suite *StaticPodWatchdogSuite) TestNoRestartBeforeThreshold() {
+	suite.createConfig(&v1alpha1.StaticPodWatchdogConfig{
+		WatchdogUnhealthyThreshold: time.Second,
+	})
+
+	suite.createFailedPodStatus("kube-system/kube-controller-manager-node1")
+
+	time.Sleep(500 * time.Millisecond)
+
+	suite.Assert().NoError(suite.assertRestarted(nil))
+}
+
+func (suite *StaticPodWatchdogSuite) TestNoRestartNeverReady() {
+	suite.createConfig(&v1alpha1.StaticPodWatchdogConfig{
+		WatchdogUnhealthyThreshold: 100 * time.Millisecond,
+	})
+
+	suite.createPodStatus("kube-system/kube-apiserver-node1", v1.ConditionFalse)
+
+	time.Sleep(time.Second)
+
+	suite.Assert().NoError(suite.assertRestarted(nil))
+}
+
+func (suite *StaticPodWatchdogSuite) TestDisabled() {
+	suite.createConfig(&v1alpha1.StaticPodWatchdogConfig{
+		WatchdogDisabled:           true,
+		WatchdogUnhealthyThreshold: 100 * time.Millisecond,
+	})
+
+	suite.createPodStatus("kube-system/kube-apiserver-node1", v1.ConditionFalse)
+
+	time.Sleep(time.Second)
+
+	suite.Assert().NoError(suite.assertRestarted(nil))
+}
+
+func (suite *StaticPodWatchdogSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestStaticPodWatchdogSuite(t *testing.T) {
+	suite.Run(t, new(StaticPodWatchdogSuite))
+}
@@ -7,7 +7,6 @@ package network
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -177,7 +176,7 @@ func (ctrl *HostnameConfigController) getDefault(defaultAddr *network.NodeAddres
 		return
 	}
 
-	spec.Hostname = fmt.Sprintf("talos-%s", strings.ReplaceAll(strings.ReplaceAll(defaultAddr.TypedSpec().Addresses[0].String(), ":", ""), ".", "-"))
+	spec.Hostname = network.DefaultHostname(defaultAddr.TypedSpec().Addresses[0])
 	spec.ConfigLayer = network.ConfigDefault
 
 	return spec
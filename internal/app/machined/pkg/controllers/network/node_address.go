@@ -18,7 +18,7 @@ import (
 	"github.com/talos-systems/talos/pkg/resources/network"
 )
 
-// NodeAddressController manages secrets.Etcd based on configuration.
+// NodeAddressController manages network.NodeAddress based on address and link status, and address filters.
 type NodeAddressController struct{}
 
 // Name implements controller.Controller interface.
@@ -39,6 +39,11 @@ func (ctrl *NodeAddressController) Inputs() []controller.Input {
 			Type:      network.LinkStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressFilterType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -91,8 +96,8 @@ func (ctrl *NodeAddressController) Run(ctx context.Context, r controller.Runtime
 		var (
 			defaultAddress      netaddr.IP
 			defaultAddrLinkName string
-			current             []netaddr.IP
-			accumulative        []netaddr.IP
+			current             []linkAddress
+			accumulative        []linkAddress
 		)
 
 		for _, r := range addresses.Items {
@@ -114,15 +119,20 @@ func (ctrl *NodeAddressController) Run(ctx context.Context, r controller.Runtime
 				defaultAddrLinkName = addr.TypedSpec().LinkName
 			}
 
+			address := linkAddress{
+				ip:       ip,
+				linkName: addr.TypedSpec().LinkName,
+			}
+
 			if _, up := linksUp[addr.TypedSpec().LinkIndex]; up {
-				current = append(current, ip)
+				current = append(current, address)
 			}
 
-			accumulative = append(accumulative, ip)
+			accumulative = append(accumulative, address)
 		}
 
 		// sort current addresses
-		sort.Slice(current, func(i, j int) bool { return current[i].Compare(current[j]) < 0 })
+		sort.Slice(current, func(i, j int) bool { return current[i].ip.Compare(current[j].ip) < 0 })
 
 		// update output resources
 		if !defaultAddress.IsZero() {
@@ -143,40 +153,125 @@ func (ctrl *NodeAddressController) Run(ctx context.Context, r controller.Runtime
 			}
 		}
 
-		if err = r.Modify(ctx, network.NewNodeAddress(network.NamespaceName, network.NodeAddressCurrentID), func(r resource.Resource) error {
-			spec := r.(*network.NodeAddress).TypedSpec()
+		touchedIDs := map[resource.ID]struct{}{
+			network.NodeAddressDefaultID: {},
+		}
 
-			spec.Addresses = current
+		if err = ctrl.updateCurrent(ctx, r, network.NodeAddressCurrentID, filterIPs(current, nil)); err != nil {
+			return err
+		}
 
-			return nil
-		}); err != nil {
-			return fmt.Errorf("error updating output resource: %w", err)
+		if err = ctrl.updateAccumulative(ctx, r, network.NodeAddressAccumulativeID, filterIPs(accumulative, nil)); err != nil {
+			return err
 		}
 
-		if err = r.Modify(ctx, network.NewNodeAddress(network.NamespaceName, network.NodeAddressAccumulativeID), func(r resource.Resource) error {
-			spec := r.(*network.NodeAddress).TypedSpec()
+		touchedIDs[network.NodeAddressCurrentID] = struct{}{}
+		touchedIDs[network.NodeAddressAccumulativeID] = struct{}{}
+
+		// build filtered address sets
+		filters, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressFilterType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing address filters: %w", err)
+		}
 
-			for _, ip := range accumulative {
-				ip := ip
+		for _, res := range filters.Items {
+			filterID := res.Metadata().ID()
+			filter := res.(*network.NodeAddressFilter).TypedSpec()
 
-				// find insert position using binary search
-				i := sort.Search(len(spec.Addresses), func(j int) bool {
-					return !spec.Addresses[j].Less(ip)
-				})
+			currentID := network.FilteredNodeAddressID(network.NodeAddressCurrentID, filterID)
+			accumulativeID := network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filterID)
 
-				if i < len(spec.Addresses) && spec.Addresses[i].Compare(ip) == 0 {
-					continue
+			if err = ctrl.updateCurrent(ctx, r, currentID, filterIPs(current, filter)); err != nil {
+				return err
+			}
+
+			if err = ctrl.updateAccumulative(ctx, r, accumulativeID, filterIPs(accumulative, filter)); err != nil {
+				return err
+			}
+
+			touchedIDs[currentID] = struct{}{}
+			touchedIDs[accumulativeID] = struct{}{}
+		}
+
+		// clean up filtered sets for removed filters
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up addresses: %w", err)
 				}
+			}
+		}
+	}
+}
+
+func (ctrl *NodeAddressController) updateCurrent(ctx context.Context, r controller.Runtime, id resource.ID, current []netaddr.IP) error {
+	if err := r.Modify(ctx, network.NewNodeAddress(network.NamespaceName, id), func(r resource.Resource) error {
+		spec := r.(*network.NodeAddress).TypedSpec()
+
+		spec.Addresses = current
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating output resource: %w", err)
+	}
+
+	return nil
+}
+
+func (ctrl *NodeAddressController) updateAccumulative(ctx context.Context, r controller.Runtime, id resource.ID, accumulative []netaddr.IP) error {
+	if err := r.Modify(ctx, network.NewNodeAddress(network.NamespaceName, id), func(r resource.Resource) error {
+		spec := r.(*network.NodeAddress).TypedSpec()
+
+		for _, ip := range accumulative {
+			ip := ip
+
+			// find insert position using binary search
+			i := sort.Search(len(spec.Addresses), func(j int) bool {
+				return !spec.Addresses[j].Less(ip)
+			})
 
-				// insert at position i
-				spec.Addresses = append(spec.Addresses, netaddr.IP{})
-				copy(spec.Addresses[i+1:], spec.Addresses[i:])
-				spec.Addresses[i] = ip
+			if i < len(spec.Addresses) && spec.Addresses[i].Compare(ip) == 0 {
+				continue
 			}
 
-			return nil
-		}); err != nil {
-			return fmt.Errorf("error updating output resource: %w", err)
+			// insert at position i
+			spec.Addresses = append(spec.Addresses, netaddr.IP{})
+			copy(spec.Addresses[i+1:], spec.Addresses[i:])
+			spec.Addresses[i] = ip
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating output resource: %w", err)
+	}
+
+	return nil
+}
+
+// linkAddress is the address with the name of the link it is assigned to.
+type linkAddress struct {
+	ip       netaddr.IP
+	linkName string
+}
+
+// filterIPs returns the addresses passing the filter, nil filter passes all addresses.
+func filterIPs(addrs []linkAddress, filter *network.NodeAddressFilterSpec) []netaddr.IP {
+	result := make([]netaddr.IP, 0, len(addrs))
+
+	for _, addr := range addrs {
+		if filter == nil || filter.Match(addr.ip, addr.linkName) {
+			result = append(result, addr.ip)
 		}
 	}
+
+	return result
 }
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+// NodeAddressFilterConfigController manages network.NodeAddressFilter based on machine configuration.
+type NodeAddressFilterConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeAddressFilterConfigController) Name() string {
+	return "network.NodeAddressFilterConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeAddressFilterConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.ToString(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeAddressFilterConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NodeAddressFilterType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NodeAddressFilterConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			for _, filter := range cfg.(*config.MachineConfig).Config().Machine().Network().AddressFilters() {
+				filter := filter
+
+				var includeSubnets, excludeSubnets []netaddr.IPPrefix
+
+				if includeSubnets, err = parsePrefixes(filter.IncludeSubnets()); err != nil {
+					return fmt.Errorf("error parsing address filter %q: %w", filter.Name(), err)
+				}
+
+				if excludeSubnets, err = parsePrefixes(filter.ExcludeSubnets()); err != nil {
+					return fmt.Errorf("error parsing address filter %q: %w", filter.Name(), err)
+				}
+
+				if err = r.Modify(ctx, network.NewNodeAddressFilter(network.NamespaceName, filter.Name()), func(r resource.Resource) error {
+					spec := r.(*network.NodeAddressFilter).TypedSpec()
+
+					spec.IncludeSubnets = includeSubnets
+					spec.ExcludeSubnets = excludeSubnets
+					spec.IncludeLinks = filter.IncludeInterfaces()
+					spec.ExcludeLinks = filter.ExcludeInterfaces()
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating output resource: %w", err)
+				}
+
+				touchedIDs[filter.Name()] = struct{}{}
+			}
+		}
+
+		// list filters for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressFilterType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up filters: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func parsePrefixes(cidrs []string) ([]netaddr.IPPrefix, error) {
+	result := make([]netaddr.IPPrefix, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		prefix, err := netaddr.ParseIPPrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, prefix)
+	}
+
+	return result, nil
+}
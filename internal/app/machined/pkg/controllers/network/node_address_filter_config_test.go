@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+type NodeAddressFilterConfigSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+func (suite *NodeAddressFilterConfigSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+}
+
+func (suite *NodeAddressFilterConfigSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *NodeAddressFilterConfigSuite) assertFilter(id string, check func(*network.NodeAddressFilter) error) error {
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressFilterType, id, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		return err
+	}
+
+	if err = check(r.(*network.NodeAddressFilter)); err != nil {
+		return retry.ExpectedError(err)
+	}
+
+	return nil
+}
+
+func (suite *NodeAddressFilterConfigSuite) assertNoFilter(id string) error {
+	_, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressFilterType, id, resource.VersionUndefined))
+	if err == nil {
+		return retry.ExpectedError(fmt.Errorf("filter %q is still there", id))
+	}
+
+	if state.IsNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (suite *NodeAddressFilterConfigSuite) TestMachineConfiguration() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.NodeAddressFilterConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkAddressFilters: []*v1alpha1.AddressFilter{
+					{
+						FilterName:              "internal",
+						FilterIncludeSubnets:    []string{"10.0.0.0/8"},
+						FilterExcludeSubnets:    []string{"10.96.0.0/12"},
+						FilterExcludeInterfaces: []string{"kube-bridge"},
+					},
+					{
+						FilterName:              "eth1",
+						FilterIncludeInterfaces: []string{"eth1"},
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertFilter("internal", func(r *network.NodeAddressFilter) error {
+				suite.Assert().Equal([]netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.0.0.0/8")}, r.TypedSpec().IncludeSubnets)
+				suite.Assert().Equal([]netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.96.0.0/12")}, r.TypedSpec().ExcludeSubnets)
+				suite.Assert().Empty(r.TypedSpec().IncludeLinks)
+				suite.Assert().Equal([]string{"kube-bridge"}, r.TypedSpec().ExcludeLinks)
+
+				return nil
+			})
+		}))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertFilter("eth1", func(r *network.NodeAddressFilter) error {
+				suite.Assert().Empty(r.TypedSpec().IncludeSubnets)
+				suite.Assert().Equal([]string{"eth1"}, r.TypedSpec().IncludeLinks)
+
+				return nil
+			})
+		}))
+
+	_, err = suite.state.UpdateWithConflicts(suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+		r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkAddressFilters = nil
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			if err := suite.assertNoFilter("internal"); err != nil {
+				return err
+			}
+
+			return suite.assertNoFilter("eth1")
+		}))
+}
+
+func (suite *NodeAddressFilterConfigSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	err := suite.state.Create(context.Background(), config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	}))
+	if state.IsConflictError(err) {
+		err = suite.state.Destroy(context.Background(), config.NewMachineConfig(nil).Metadata())
+	}
+
+	suite.Require().NoError(err)
+}
+
+func TestNodeAddressFilterConfigSuite(t *testing.T) {
+	suite.Run(t, new(NodeAddressFilterConfigSuite))
+}
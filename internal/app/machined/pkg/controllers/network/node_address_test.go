@@ -21,6 +21,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
 
 	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/talos-systems/talos/pkg/logging"
@@ -125,6 +126,74 @@ func (suite *NodeAddressSuite) TestDefaults() {
 		}))
 }
 
+func (suite *NodeAddressSuite) TestFilters() {
+	filter1 := network.NewNodeAddressFilter(network.NamespaceName, "no-ipv6")
+	filter1.TypedSpec().ExcludeSubnets = []netaddr.IPPrefix{netaddr.MustParseIPPrefix("::/0")}
+	suite.Require().NoError(suite.state.Create(suite.ctx, filter1))
+
+	filter2 := network.NewNodeAddressFilter(network.NamespaceName, "only-ipv6")
+	filter2.TypedSpec().IncludeSubnets = []netaddr.IPPrefix{netaddr.MustParseIPPrefix("::/0")}
+	suite.Require().NoError(suite.state.Create(suite.ctx, filter2))
+
+	filter3 := network.NewNodeAddressFilter(network.NamespaceName, "no-links")
+	filter3.TypedSpec().IncludeLinks = []string{"doesnotexist0"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, filter3))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertAddresses([]string{
+				network.NodeAddressCurrentID,
+				network.NodeAddressAccumulativeID,
+				network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter1.Metadata().ID()),
+				network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter1.Metadata().ID()),
+				network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter2.Metadata().ID()),
+				network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter2.Metadata().ID()),
+				network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter3.Metadata().ID()),
+				network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter3.Metadata().ID()),
+			}, func(r *network.NodeAddress) error {
+				addrs := r.TypedSpec().Addresses
+
+				suite.T().Logf("id %q val %s", r.Metadata().ID(), addrs)
+
+				switch r.Metadata().ID() {
+				case network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter1.Metadata().ID()),
+					network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter1.Metadata().ID()):
+					for _, addr := range addrs {
+						suite.Assert().True(addr.Is4(), "address %s", addr)
+					}
+				case network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter2.Metadata().ID()),
+					network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter2.Metadata().ID()):
+					for _, addr := range addrs {
+						suite.Assert().True(addr.Is6(), "address %s", addr)
+					}
+				case network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter3.Metadata().ID()),
+					network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, filter3.Metadata().ID()):
+					suite.Assert().Empty(addrs)
+				}
+
+				return nil
+			})
+		}))
+
+	// removing the filter should remove filtered addresses
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, filter2.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType,
+				network.FilteredNodeAddressID(network.NodeAddressCurrentID, filter2.Metadata().ID()), resource.VersionUndefined))
+			if err == nil {
+				return retry.ExpectedError(fmt.Errorf("filtered addresses still exist"))
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}))
+}
+
 func (suite *NodeAddressSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -135,6 +204,7 @@ func (suite *NodeAddressSuite) TearDownTest() {
 	// trigger updates in resources to stop watch loops
 	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewAddressStatus(network.NamespaceName, "bar")))
 	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewLinkStatus(network.NamespaceName, "bar")))
+	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewNodeAddressFilter(network.NamespaceName, "bar")))
 }
 
 func TestNodeAddressSuite(t *testing.T) {
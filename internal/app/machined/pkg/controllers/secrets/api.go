@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/AlekSi/pointer"
@@ -142,7 +143,7 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.NodeAddressType,
-			ID:        pointer.ToString(network.NodeAddressAccumulativeID),
+			ID:        pointer.ToString(network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, k8s.NodeAddressFilterNoK8s)),
 			Kind:      controller.InputWeak,
 		},
 		{
@@ -240,7 +241,7 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 
 		hostnameStatus := hostnameResource.(*network.HostnameStatus).TypedSpec()
 
-		addressesResource, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.NodeAddressAccumulativeID, resource.VersionUndefined))
+		addressesResource, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, k8s.NodeAddressFilterNoK8s), resource.VersionUndefined))
 		if err != nil {
 			if state.IsNotFoundError(err) {
 				continue
@@ -286,14 +287,21 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 			ips = append(ips, ip.IPAddr().IP)
 		}
 
-		dnsNames := make([]string, 0, len(rootSpec.CertSANDNSNames)+2)
+		// if the hostname is not set yet, use the deterministic default hostname based on the node address,
+		// so that the certificate never contains an empty DNS name
+		if strings.TrimSpace(hostnameStatus.Hostname) == "" && len(nodeAddresses.Addresses) > 0 {
+			hostnameStatus = &network.HostnameStatusSpec{
+				Hostname:   network.DefaultHostname(nodeAddresses.Addresses[0]),
+				Domainname: hostnameStatus.Domainname,
+			}
+		}
 
-		dnsNames = append(dnsNames, rootSpec.CertSANDNSNames...)
-		dnsNames = append(dnsNames, hostnameStatus.Hostname)
+		var altNames AltNames
 
-		if hostnameStatus.FQDN() != hostnameStatus.Hostname {
-			dnsNames = append(dnsNames, hostnameStatus.FQDN())
-		}
+		altNames.AppendDNSNames(rootSpec.CertSANDNSNames...)
+		altNames.AppendDNSNames(hostnameStatus.DNSNames()...)
+
+		dnsNames := altNames.DNSNames
 
 		if isControlplane {
 			if err := ctrl.generateControlPlane(ctx, r, logger, rootSpec, ips, dnsNames, hostnameStatus.FQDN()); err != nil {
@@ -340,6 +348,7 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 			apiSecrets.CA = &x509.PEMEncodedCertificateAndKey{
 				Crt: rootSpec.CA.Crt,
 			}
+			apiSecrets.AcceptedCAs = rootSpec.AcceptedCAs
 			apiSecrets.Server = x509.NewCertificateAndKeyFromKeyPair(serverCert)
 			apiSecrets.Client = x509.NewCertificateAndKeyFromKeyPair(clientCert)
 
@@ -404,6 +413,7 @@ func (ctrl *APIController) generateJoin(ctx context.Context, r controller.Runtim
 			apiSecrets.CA = &x509.PEMEncodedCertificateAndKey{
 				Crt: ca,
 			}
+			apiSecrets.AcceptedCAs = rootSpec.AcceptedCAs
 			apiSecrets.Server = serverCert
 			apiSecrets.Client = clientCert
 
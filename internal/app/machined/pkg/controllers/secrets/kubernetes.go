@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/AlekSi/pointer"
@@ -19,10 +20,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/talos-systems/crypto/x509"
 	"go.uber.org/zap"
+	"inet.af/netaddr"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
 	"github.com/talos-systems/talos/pkg/resources/network"
 	"github.com/talos-systems/talos/pkg/resources/secrets"
 	timeresource "github.com/talos-systems/talos/pkg/resources/time"
@@ -34,8 +38,20 @@ import (
 // Controller automatically refreshes certs at 50% of CertificateValidityDuration.
 const KubernetesCertificateValidityDuration = constants.KubernetesDefaultCertificateValidityDuration
 
+// KubernetesRetryMaxInterval is the maximum interval between retries when Kubernetes secrets can't be generated.
+const KubernetesRetryMaxInterval = time.Minute
+
+// KubernetesNetworkWaitTimeout is the time to wait for the network to become ready before reporting it in the status.
+const KubernetesNetworkWaitTimeout = 5 * time.Minute
+
 // KubernetesController manages secrets.Kubernetes based on configuration.
-type KubernetesController struct{}
+type KubernetesController struct {
+	// RateLimitInterval limits how often secrets are regenerated in response to input changes
+	// (e.g. node IP or root secrets updates), zero value disables rate limiting.
+	//
+	// Periodic certificate refresh and retries on invalid root CAs are not rate-limited.
+	RateLimitInterval time.Duration
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *KubernetesController) Name() string {
@@ -61,6 +77,10 @@ func (ctrl *KubernetesController) Outputs() []controller.Output {
 			Type: secrets.KubernetesType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: secrets.KubernetesStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -68,13 +88,36 @@ func (ctrl *KubernetesController) Outputs() []controller.Output {
 //
 //nolint:gocyclo
 func (ctrl *KubernetesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// if the network doesn't become ready in time, report it in the status, so that it's visible
+	// why Kubernetes secrets are not generated
+	networkWaitTimer := time.NewTimer(KubernetesNetworkWaitTimeout)
+	defer networkWaitTimer.Stop()
+
+	var (
+		networkStatus   *network.StatusSpec
+		networkTimedOut bool
+	)
+
 	// wait for the network to be ready first, then switch to regular inputs
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-networkWaitTimer.C:
+			networkTimedOut = true
+
+			notReadyErr := networkNotReadyError(networkStatus)
+
+			logger.Warn("Kubernetes secrets generation is blocked", zap.Error(notReadyErr))
+
+			if err := ctrl.updateStatus(ctx, r, notReadyErr); err != nil {
+				return err
+			}
+
+			continue
 		}
+
 		// wait for network to be ready as it might change IPs/hostname
 		networkResource, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.StatusType, network.StatusID, resource.VersionUndefined))
 		if err != nil {
@@ -85,11 +128,17 @@ func (ctrl *KubernetesController) Run(ctx context.Context, r controller.Runtime,
 			return err
 		}
 
-		networkStatus := networkResource.(*network.Status).TypedSpec()
+		networkStatus = networkResource.(*network.Status).TypedSpec()
 
 		if networkStatus.AddressReady && networkStatus.HostnameReady {
 			break
 		}
+
+		if networkTimedOut {
+			if err = ctrl.updateStatus(ctx, r, networkNotReadyError(networkStatus)); err != nil {
+				return err
+			}
+		}
 	}
 
 	// switch to regular inputs once the network is ready
@@ -106,21 +155,48 @@ func (ctrl *KubernetesController) Run(ctx context.Context, r controller.Runtime,
 			ID:        pointer.ToString(timeresource.StatusID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.NodeIPType,
+			ID:        pointer.ToString(k8s.KubeletID),
+			Kind:      controller.InputWeak,
+		},
 	}); err != nil {
 		return fmt.Errorf("error updating inputs: %w", err)
 	}
 
 	r.QueueReconcile()
 
+	// input changes might be rate-limited, while refreshTicker triggers regeneration
+	// as the certificates approach expiration independent of the rate limit
+	eventCh := r.EventCh()
+
+	if ctrl.RateLimitInterval > 0 {
+		eventCh = controllers.RateLimitEvents(ctx, eventCh, ctrl.RateLimitInterval)
+	}
+
 	refreshTicker := time.NewTicker(KubernetesCertificateValidityDuration / 2)
 	defer refreshTicker.Stop()
 
+	var (
+		retryTimer    *time.Timer
+		retryCh       <-chan time.Time
+		retryInterval time.Duration
+	)
+
+	defer func() {
+		if retryTimer != nil {
+			retryTimer.Stop()
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-r.EventCh():
+		case <-eventCh:
 		case <-refreshTicker.C:
+		case <-retryCh:
 		}
 
 		k8sRootRes, err := r.Get(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.RootType, secrets.RootKubernetesID, resource.VersionUndefined))
@@ -152,24 +228,134 @@ func (ctrl *KubernetesController) Run(ctx context.Context, r controller.Runtime,
 			continue
 		}
 
+		// node IP is optional, it's added to the cert SANs once picked
+		var nodeIPs []netaddr.IP
+
+		nodeIPResource, err := r.Get(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.NodeIPType, k8s.KubeletID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting node IP: %w", err)
+			}
+		} else {
+			nodeIPs = nodeIPResource.(*k8s.NodeIP).TypedSpec().Addresses
+		}
+
+		// invalid root CA can be only fixed by updating machine configuration,
+		// so keep the controller running and retry with a backoff instead of failing
+		if err = ctrl.validateRootCAs(k8sRoot); err != nil {
+			retryInterval = nextRetryInterval(retryInterval)
+
+			logger.Warn("failed to generate Kubernetes secrets", zap.Error(err), zap.Duration("retry_in", retryInterval))
+
+			if err = ctrl.updateStatus(ctx, r, err); err != nil {
+				return err
+			}
+
+			if retryTimer != nil {
+				retryTimer.Stop()
+			}
+
+			retryTimer = time.NewTimer(retryInterval)
+
+			retryCh = retryTimer.C
+
+			continue
+		}
+
+		retryInterval, retryCh = 0, nil
+
 		if err = r.Modify(ctx, secrets.NewKubernetes(), func(r resource.Resource) error {
-			return ctrl.updateSecrets(k8sRoot, r.(*secrets.Kubernetes).Certs())
+			return ctrl.updateSecrets(k8sRoot, nodeIPs, r.(*secrets.Kubernetes).Certs())
 		}); err != nil {
 			return err
 		}
+
+		if err = ctrl.updateStatus(ctx, r, nil); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *KubernetesController) validateRootCAs(k8sRoot *secrets.RootKubernetesSpec) error {
+	if _, err := x509.NewCertificateAuthorityFromCertificateAndKey(k8sRoot.CA); err != nil {
+		return fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	// aggregator CA is not set if the aggregation layer is disabled
+	if k8sRoot.AggregatorCA != nil {
+		if _, err := x509.NewCertificateAuthorityFromCertificateAndKey(k8sRoot.AggregatorCA); err != nil {
+			return fmt.Errorf("failed to parse aggregator CA: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *KubernetesController) updateStatus(ctx context.Context, r controller.Runtime, secretsErr error) error {
+	if err := r.Modify(ctx, secrets.NewKubernetesStatus(), func(r resource.Resource) error {
+		status := r.(*secrets.KubernetesStatus).TypedSpec()
+
+		status.Ready = secretsErr == nil
+		status.Error = ""
+
+		if secretsErr != nil {
+			status.Error = secretsErr.Error()
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating status: %w", err)
+	}
+
+	return nil
+}
+
+func networkNotReadyError(networkStatus *network.StatusSpec) error {
+	if networkStatus == nil {
+		return fmt.Errorf("waiting for network to be ready: network status is not available")
+	}
+
+	var notReady []string
+
+	if !networkStatus.AddressReady {
+		notReady = append(notReady, "address")
+	}
+
+	if !networkStatus.HostnameReady {
+		notReady = append(notReady, "hostname")
+	}
+
+	return fmt.Errorf("waiting for network to be ready: %s not ready", strings.Join(notReady, ", "))
+}
+
+func nextRetryInterval(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return time.Second
 	}
+
+	interval *= 2
+
+	if interval > KubernetesRetryMaxInterval {
+		interval = KubernetesRetryMaxInterval
+	}
+
+	return interval
 }
 
 //nolint:gocyclo
-func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.RootKubernetesSpec, k8sSecrets *secrets.KubernetesCertsSpec) error {
+func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.RootKubernetesSpec, nodeIPs []netaddr.IP, k8sSecrets *secrets.KubernetesCertsSpec) error {
 	urls := []string{k8sRoot.Endpoint.Hostname()}
 	urls = append(urls, k8sRoot.CertSANs...)
 	altNames := altNamesFromURLs(urls)
 
-	altNames.IPs = append(altNames.IPs, k8sRoot.APIServerIPs...)
+	altNames.AppendIPs(k8sRoot.APIServerIPs...)
+
+	for _, ip := range nodeIPs {
+		altNames.AppendIPs(ip.IPAddr().IP)
+	}
 
 	// Add kubernetes default svc with cluster domain to AltNames
-	altNames.DNSNames = append(altNames.DNSNames,
+	altNames.AppendDNSNames(
 		"kubernetes",
 		"kubernetes.default",
 		"kubernetes.default.svc",
@@ -188,7 +374,7 @@ func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.RootKubernetesS
 	}
 
 	if !localhostFound {
-		altNames.DNSNames = append(altNames.DNSNames, "localhost")
+		altNames.AppendDNSNames("localhost")
 	}
 
 	ca, err := x509.NewCertificateAuthorityFromCertificateAndKey(k8sRoot.CA)
@@ -228,6 +414,21 @@ func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.RootKubernetesS
 
 	k8sSecrets.APIServerKubeletClient = x509.NewCertificateAndKeyFromKeyPair(apiServerKubeletClient)
 
+	if err = ctrl.updateFrontProxy(k8sRoot, k8sSecrets); err != nil {
+		return err
+	}
+
+	return ctrl.updateKubeconfigs(k8sRoot, k8sSecrets)
+}
+
+// updateFrontProxy generates front-proxy client certificate, unless the aggregation layer is disabled.
+func (ctrl *KubernetesController) updateFrontProxy(k8sRoot *secrets.RootKubernetesSpec, k8sSecrets *secrets.KubernetesCertsSpec) error {
+	if k8sRoot.AggregatorCA == nil {
+		k8sSecrets.FrontProxy = nil
+
+		return nil
+	}
+
 	aggregatorCA, err := x509.NewCertificateAuthorityFromCertificateAndKey(k8sRoot.AggregatorCA)
 	if err != nil {
 		return fmt.Errorf("failed to parse aggregator CA: %w", err)
@@ -247,68 +448,72 @@ func (ctrl *KubernetesController) updateSecrets(k8sRoot *secrets.RootKubernetesS
 
 	k8sSecrets.FrontProxy = x509.NewCertificateAndKeyFromKeyPair(frontProxy)
 
-	var buf bytes.Buffer
+	return nil
+}
 
-	if err = kubeconfig.Generate(&kubeconfig.GenerateInput{
-		ClusterName: k8sRoot.Name,
+// updateKubeconfigs generates kubeconfigs for the control plane components.
+//
+// Kubeconfigs are refreshed along with the certificates, as they embed client certificates.
+func (ctrl *KubernetesController) updateKubeconfigs(k8sRoot *secrets.RootKubernetesSpec, k8sSecrets *secrets.KubernetesCertsSpec) error {
+	var err error
 
-		CA:                  k8sRoot.CA,
-		CertificateLifetime: KubernetesCertificateValidityDuration,
+	k8sSecrets.ControllerManagerKubeconfig, err = localKubeconfig(k8sRoot, constants.KubernetesControllerManagerOrganization)
+	if err != nil {
+		return fmt.Errorf("failed to generate controller manager kubeconfig: %w", err)
+	}
 
-		CommonName:   constants.KubernetesControllerManagerOrganization,
-		Organization: constants.KubernetesControllerManagerOrganization,
+	k8sSecrets.SchedulerKubeconfig, err = localKubeconfig(k8sRoot, constants.KubernetesSchedulerOrganization)
+	if err != nil {
+		return fmt.Errorf("failed to generate scheduler kubeconfig: %w", err)
+	}
 
-		Endpoint:    "https://localhost:6443/",
-		Username:    constants.KubernetesControllerManagerOrganization,
-		ContextName: "default",
-	}, &buf); err != nil {
-		return fmt.Errorf("failed to generate controller manager kubeconfig: %w", err)
+	var buf bytes.Buffer
+
+	if err = kubeconfig.GenerateAdmin(&generateAdminAdapter{k8sRoot: k8sRoot}, &buf); err != nil {
+		return fmt.Errorf("failed to generate admin kubeconfig: %w", err)
 	}
 
-	k8sSecrets.ControllerManagerKubeconfig = buf.String()
+	k8sSecrets.AdminKubeconfig = buf.String()
 
-	buf.Reset()
+	return nil
+}
+
+// localKubeconfig generates kubeconfig for the control plane component which connects to the local API server.
+func localKubeconfig(k8sRoot *secrets.RootKubernetesSpec, component string) (string, error) {
+	var buf bytes.Buffer
 
-	if err = kubeconfig.Generate(&kubeconfig.GenerateInput{
+	if err := kubeconfig.Generate(&kubeconfig.GenerateInput{
 		ClusterName: k8sRoot.Name,
 
 		CA:                  k8sRoot.CA,
 		CertificateLifetime: KubernetesCertificateValidityDuration,
 
-		CommonName:   constants.KubernetesSchedulerOrganization,
-		Organization: constants.KubernetesSchedulerOrganization,
+		CommonName:   component,
+		Organization: component,
 
 		Endpoint:    "https://localhost:6443/",
-		Username:    constants.KubernetesSchedulerOrganization,
+		Username:    component,
 		ContextName: "default",
 	}, &buf); err != nil {
-		return fmt.Errorf("failed to generate scheduler kubeconfig: %w", err)
-	}
-
-	k8sSecrets.SchedulerKubeconfig = buf.String()
-
-	buf.Reset()
-
-	if err = kubeconfig.GenerateAdmin(&generateAdminAdapter{k8sRoot: k8sRoot}, &buf); err != nil {
-		return fmt.Errorf("failed to generate admin kubeconfig: %w", err)
+		return "", err
 	}
 
-	k8sSecrets.AdminKubeconfig = buf.String()
-
-	return nil
+	return buf.String(), nil
 }
 
 func (ctrl *KubernetesController) teardownAll(ctx context.Context, r controller.Runtime) error {
-	list, err := r.List(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, "", resource.VersionUndefined))
-	if err != nil {
-		return err
-	}
+	for _, resourceType := range []resource.Type{secrets.KubernetesType, secrets.KubernetesStatusType} {
+		list, err := r.List(ctx, resource.NewMetadata(secrets.NamespaceName, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return err
+		}
 
-	// TODO: change this to proper teardown sequence
+		// TODO: change this to proper teardown sequence
 
-	for _, res := range list.Items {
-		if err = r.Destroy(ctx, res.Metadata()); err != nil {
-			return err
+		for _, res := range list.Items {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -321,18 +526,62 @@ type AltNames struct {
 	DNSNames []string
 }
 
+// AppendIPs appends IP addresses skipping empty and duplicate ones.
+//
+// IPv4 addresses are normalized to the 4-byte form, so that IPv4-mapped IPv6 addresses
+// and native IPv4 addresses are deduplicated.
+func (an *AltNames) AppendIPs(ips ...net.IP) {
+ipLoop:
+	for _, ip := range ips {
+		if len(ip) == 0 || ip.IsUnspecified() {
+			continue
+		}
+
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		} else {
+			ip = ip.To16()
+		}
+
+		if ip == nil {
+			continue
+		}
+
+		for _, existing := range an.IPs {
+			if existing.Equal(ip) {
+				continue ipLoop
+			}
+		}
+
+		an.IPs = append(an.IPs, ip)
+	}
+}
+
+// AppendDNSNames appends DNS names skipping empty (or whitespace-only) ones.
+func (an *AltNames) AppendDNSNames(dnsNames ...string) {
+	for _, dnsName := range dnsNames {
+		dnsName = strings.TrimSpace(dnsName)
+
+		if dnsName == "" {
+			continue
+		}
+
+		an.DNSNames = append(an.DNSNames, dnsName)
+	}
+}
+
 func altNamesFromURLs(urls []string) *AltNames {
 	var an AltNames
 
 	for _, u := range urls {
 		ip := net.ParseIP(u)
 		if ip != nil {
-			an.IPs = append(an.IPs, ip)
+			an.AppendIPs(ip)
 
 			continue
 		}
 
-		an.DNSNames = append(an.DNSNames, u)
+		an.AppendDNSNames(u)
 	}
 
 	return &an
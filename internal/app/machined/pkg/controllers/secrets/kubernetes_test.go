@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/crypto/x509"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+	"k8s.io/client-go/tools/clientcmd"
+
+	secretsctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+	"github.com/talos-systems/talos/pkg/resources/network"
+	"github.com/talos-systems/talos/pkg/resources/secrets"
+	timeresource "github.com/talos-systems/talos/pkg/resources/time"
+)
+
+type KubernetesSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+func (suite *KubernetesSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&secretsctrl.KubernetesController{
+		RateLimitInterval: 100 * time.Millisecond,
+	}))
+
+	suite.startRuntime()
+}
+
+func (suite *KubernetesSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *KubernetesSuite) newCA() *x509.PEMEncodedCertificateAndKey {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	return x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+}
+
+func (suite *KubernetesSuite) createInputs() {
+	networkStatus := network.NewStatus(network.NamespaceName, network.StatusID)
+	networkStatus.TypedSpec().AddressReady = true
+	networkStatus.TypedSpec().HostnameReady = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, networkStatus))
+
+	timeStatus := timeresource.NewStatus()
+	timeStatus.SetStatus(timeresource.StatusSpec{
+		Synced: true,
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeStatus))
+
+	nodeIP := k8s.NewNodeIP(k8s.ControlPlaneNamespaceName, k8s.KubeletID)
+	nodeIP.TypedSpec().Addresses = []netaddr.IP{netaddr.MustParseIP("172.20.0.2"), netaddr.MustParseIP("2001:db8::2")}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeIP))
+
+	u, err := url.Parse("https://foo.example.com:6443")
+	suite.Require().NoError(err)
+
+	rootSecrets := secrets.NewRoot(secrets.RootKubernetesID)
+	rootSpec := rootSecrets.KubernetesSpec()
+	rootSpec.Name = "test"
+	rootSpec.Endpoint = u
+	rootSpec.CertSANs = []string{"example.org", "10.5.0.1", "172.20.0.2"}
+	rootSpec.APIServerIPs = []net.IP{net.ParseIP("10.96.0.1")}
+	rootSpec.DNSDomain = "cluster.test"
+	rootSpec.CA = suite.newCA()
+	rootSpec.AggregatorCA = suite.newCA()
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+}
+
+func (suite *KubernetesSuite) getCerts() (*secrets.KubernetesCertsSpec, error) {
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, secrets.KubernetesID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, retry.ExpectedError(err)
+		}
+
+		return nil, err
+	}
+
+	return r.(*secrets.Kubernetes).Certs(), nil
+}
+
+func (suite *KubernetesSuite) TestReconcile() {
+	suite.createInputs()
+
+	var certs *secrets.KubernetesCertsSpec
+
+	suite.Require().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			var err error
+
+			certs, err = suite.getCerts()
+
+			return err
+		},
+	))
+
+	apiServerCert, err := certs.APIServer.GetCert()
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("kube-apiserver", apiServerCert.Subject.CommonName)
+	suite.Assert().Equal(
+		[]string{
+			"foo.example.com",
+			"example.org",
+			"kubernetes",
+			"kubernetes.default",
+			"kubernetes.default.svc",
+			"kubernetes.default.svc.cluster.test",
+			"localhost",
+		}, apiServerCert.DNSNames)
+
+	actualIPs := make([]string, 0, len(apiServerCert.IPAddresses))
+
+	for _, ip := range apiServerCert.IPAddresses {
+		actualIPs = append(actualIPs, ip.String())
+	}
+
+	suite.Assert().Equal([]string{"10.5.0.1", "172.20.0.2", "10.96.0.1", "2001:db8::2"}, actualIPs)
+
+	frontProxyCert, err := certs.FrontProxy.GetCert()
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("front-proxy-client", frontProxyCert.Subject.CommonName)
+
+	for _, kubeconfig := range []string{
+		certs.SchedulerKubeconfig,
+		certs.ControllerManagerKubeconfig,
+		certs.AdminKubeconfig,
+	} {
+		config, err := clientcmd.Load([]byte(kubeconfig))
+		suite.Require().NoError(err)
+
+		suite.Assert().NoError(clientcmd.ConfirmUsable(*config, config.CurrentContext))
+	}
+
+	r, err := suite.state.Get(suite.ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesStatusType, secrets.KubernetesID, resource.VersionUndefined))
+	suite.Require().NoError(err)
+
+	suite.Assert().True(r.(*secrets.KubernetesStatus).TypedSpec().Ready)
+}
+
+func (suite *KubernetesSuite) TestReconcileInvalidCA() {
+	suite.createInputs()
+
+	rootSecrets := secrets.NewRoot(secrets.RootKubernetesID)
+
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, rootSecrets.Metadata(), func(r resource.Resource) error {
+		r.(*secrets.Root).KubernetesSpec().CA = &x509.PEMEncodedCertificateAndKey{}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			r, err := suite.state.Get(suite.ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesStatusType, secrets.KubernetesID, resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			}
+
+			status := r.(*secrets.KubernetesStatus).TypedSpec()
+
+			if status.Ready || status.Error == "" {
+				return retry.ExpectedError(fmt.Errorf("status is not failed yet: %v", status))
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *KubernetesSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewStatus(network.NamespaceName, "bar")))
+}
+
+func TestKubernetesSuite(t *testing.T) {
+	suite.Run(t, new(KubernetesSuite))
+}
+
+func TestAltNamesAppendIPs(t *testing.T) {
+	var altNames secretsctrl.AltNames
+
+	altNames.AppendIPs(
+		net.ParseIP("10.0.0.1"),
+		net.ParseIP("::ffff:10.0.0.1"),
+		net.IPv4(10, 0, 0, 1).To4(),
+		net.ParseIP("2001:db8::1"),
+		net.ParseIP("2001:0db8:0000::0001"),
+		net.ParseIP("fd00::1"),
+		nil,
+		net.IPv4zero,
+		net.IPv6unspecified,
+	)
+
+	assert.Equal(t, []net.IP{
+		net.ParseIP("10.0.0.1").To4(),
+		net.ParseIP("2001:db8::1"),
+		net.ParseIP("fd00::1"),
+	}, altNames.IPs)
+}
+
+func TestAltNamesAppendDNSNames(t *testing.T) {
+	var altNames secretsctrl.AltNames
+
+	altNames.AppendDNSNames("foo", "", "  ", "bar")
+
+	assert.Equal(t, []string{"foo", "bar"}, altNames.DNSNames)
+}
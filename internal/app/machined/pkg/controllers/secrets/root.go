@@ -12,10 +12,12 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/talos-systems/crypto/x509"
 	"go.uber.org/zap"
 	"inet.af/netaddr"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/resources/config"
 	"github.com/talos-systems/talos/pkg/resources/secrets"
@@ -115,6 +117,15 @@ func (ctrl *RootController) Run(ctx context.Context, r controller.Runtime, logge
 			return err
 		}
 
+		warnings, err := v1alpha1.ValidateCertSANSubnets(cfgProvider.Cluster())
+		if err != nil {
+			logger.Warn("failed to validate certificate SANs", zap.Error(err))
+		}
+
+		for _, warning := range warnings {
+			logger.Warn("API server certificate SANs misconfiguration", zap.String("warning", warning))
+		}
+
 		if err = r.Modify(ctx, secrets.NewRoot(secrets.RootKubernetesID), func(r resource.Resource) error {
 			return ctrl.updateK8sSecrets(cfgProvider, r.(*secrets.Root).KubernetesSpec())
 		}); err != nil {
@@ -126,6 +137,14 @@ func (ctrl *RootController) Run(ctx context.Context, r controller.Runtime, logge
 func (ctrl *RootController) updateOSSecrets(cfgProvider talosconfig.Provider, osSecrets *secrets.RootOSSpec) error {
 	osSecrets.CA = cfgProvider.Machine().Security().CA()
 
+	osSecrets.AcceptedCAs = nil
+
+	for _, ca := range cfgProvider.Machine().Security().AcceptedCAs() {
+		osSecrets.AcceptedCAs = append(osSecrets.AcceptedCAs, &x509.PEMEncodedCertificateAndKey{
+			Crt: ca.Crt,
+		})
+	}
+
 	osSecrets.CertSANIPs = nil
 	osSecrets.CertSANDNSNames = nil
 
@@ -165,10 +184,14 @@ func (ctrl *RootController) updateK8sSecrets(cfgProvider talosconfig.Provider, k
 		return fmt.Errorf("error building API service IPs: %w", err)
 	}
 
-	k8sSecrets.AggregatorCA = cfgProvider.Cluster().AggregatorCA()
+	if cfgProvider.Cluster().APIServer().DisableAggregator() {
+		k8sSecrets.AggregatorCA = nil
+	} else {
+		k8sSecrets.AggregatorCA = cfgProvider.Cluster().AggregatorCA()
 
-	if k8sSecrets.AggregatorCA == nil {
-		return fmt.Errorf("missing cluster.aggregatorCA secret")
+		if k8sSecrets.AggregatorCA == nil {
+			return fmt.Errorf("missing cluster.aggregatorCA secret")
+		}
 	}
 
 	k8sSecrets.CA = cfgProvider.Cluster().CA()
@@ -178,6 +201,7 @@ func (ctrl *RootController) updateK8sSecrets(cfgProvider talosconfig.Provider, k
 	}
 
 	k8sSecrets.ServiceAccount = cfgProvider.Cluster().ServiceAccount()
+	k8sSecrets.ServiceAccountAdditionalKeys = cfgProvider.Cluster().ServiceAccountAdditionalKeys()
 
 	k8sSecrets.AESCBCEncryptionSecret = cfgProvider.Cluster().AESCBCEncryptionSecret()
 
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package controllers provides common helpers for the machined controllers.
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+)
+
+// RateLimitEvents reduces the rate of reconcile events.
+//
+// RateLimitEvents makes sure that reconcile events are not coming faster than interval.
+// The first event is delivered immediately, any events which come during the limit are coalesced
+// into a single event delivered once the interval expires.
+func RateLimitEvents(ctx context.Context, in <-chan controller.ReconcileEvent, interval time.Duration) <-chan controller.ReconcileEvent {
+	return rateLimitEvents(ctx, in, interval, time.After)
+}
+
+func rateLimitEvents(ctx context.Context, in <-chan controller.ReconcileEvent, interval time.Duration,
+	after func(time.Duration) <-chan time.Time) <-chan controller.ReconcileEvent {
+	ch := make(chan controller.ReconcileEvent)
+
+	send := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case ch <- controller.ReconcileEvent{}:
+			return true
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-in:
+			}
+
+			if !send() {
+				return
+			}
+
+			limitCh := after(interval)
+			pending := false
+
+			for limitCh != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-in:
+					pending = true
+				case <-limitCh:
+					limitCh = nil
+
+					if pending {
+						if !send() {
+							return
+						}
+
+						limitCh = after(interval)
+						pending = false
+					}
+				}
+			}
+		}
+	}()
+
+	return ch
+}
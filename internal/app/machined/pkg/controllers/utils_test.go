@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package controllers //nolint:testpackage // to test unexported function(s)
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timers := make(chan chan time.Time)
+
+	after := func(d time.Duration) <-chan time.Time {
+		assert.Equal(t, time.Minute, d)
+
+		timer := make(chan time.Time, 1)
+		timers <- timer
+
+		return timer
+	}
+
+	in := make(chan controller.ReconcileEvent)
+	out := rateLimitEvents(ctx, in, time.Minute, after)
+
+	// first event goes through immediately
+	in <- controller.ReconcileEvent{}
+	<-out
+
+	timer := <-timers
+
+	// events during the interval are coalesced and delayed until the interval expires
+	in <- controller.ReconcileEvent{}
+	in <- controller.ReconcileEvent{}
+
+	select {
+	case <-out:
+		t.Fatal("event delivered before the interval expired")
+	default:
+	}
+
+	timer <- time.Now()
+	<-out
+
+	// the interval expires without new events
+	timer = <-timers
+	timer <- time.Now()
+
+	// next event goes through immediately
+	in <- controller.ReconcileEvent{}
+	<-out
+
+	<-timers
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+// ControllerStatusSource provides the state of the controllers in the controller runtime.
+type ControllerStatusSource interface {
+	// Statuses returns a snapshot of controller statuses indexed by controller name.
+	Statuses() map[string]v1alpha1.ControllerStatusSpec
+	// Notify returns a channel which receives a value when controller statuses change.
+	Notify() <-chan struct{}
+}
+
+// ControllerStatusController manages v1alpha1.ControllerStatus based on controller runtime state.
+type ControllerStatusController struct {
+	Source ControllerStatusSource
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ControllerStatusController) Name() string {
+	return "v1alpha1.ControllerStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ControllerStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ControllerStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: v1alpha1.ControllerStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ControllerStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ctrl.Source.Notify():
+		}
+
+		statuses := ctrl.Source.Statuses()
+
+		list, err := r.List(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ControllerStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		current := make(map[resource.ID]v1alpha1.ControllerStatusSpec, len(list.Items))
+
+		for _, res := range list.Items {
+			current[res.Metadata().ID()] = *res.(*v1alpha1.ControllerStatus).TypedSpec()
+		}
+
+		for name, status := range statuses {
+			status := status
+
+			// skip unchanged statuses, as every update wakes up the watchers
+			if existing, ok := current[name]; ok && controllerStatusEqual(existing, status) {
+				continue
+			}
+
+			if err = r.Modify(ctx, v1alpha1.NewControllerStatus(name), func(r resource.Resource) error {
+				*r.(*v1alpha1.ControllerStatus).TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating controller status: %w", err)
+			}
+		}
+
+		for _, res := range list.Items {
+			if _, ok := statuses[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up controller status: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func controllerStatusEqual(a, b v1alpha1.ControllerStatusSpec) bool {
+	return a.State == b.State && a.LastError == b.LastError && a.LastReconcile.Equal(b.LastReconcile)
+}
@@ -70,4 +70,5 @@ type Controller interface {
 type V1Alpha2Controller interface {
 	Run(context.Context) error
 	DependencyGraph() (*controller.DependencyGraph, error)
+	QueueReconcile(controllerName string) error
 }
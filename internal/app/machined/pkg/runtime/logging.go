@@ -4,7 +4,11 @@
 
 package runtime
 
-import "io"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 // LoggingManager provides unified interface to publish and consume logs.
 type LoggingManager interface {
@@ -15,6 +19,8 @@ type LoggingManager interface {
 type LogOptions struct {
 	Follow    bool
 	TailLines *int
+	Since     time.Time
+	Level     LogLevel
 }
 
 // LogOption provides functional options for LogHandler.Reader.
@@ -38,8 +44,55 @@ func WithTailLines(lines int) LogOption {
 	}
 }
 
+// WithSince starts log reading from the first line written at or after the specified time.
+func WithSince(since time.Time) LogOption {
+	return func(o *LogOptions) error {
+		o.Since = since
+
+		return nil
+	}
+}
+
+// WithLevel skips the log lines below the specified level.
+//
+// Lines without a recognized level are skipped as well.
+func WithLevel(level LogLevel) LogOption {
+	return func(o *LogOptions) error {
+		o.Level = level
+
+		return nil
+	}
+}
+
+// LogLevel is the severity of a log line.
+type LogLevel int
+
+// LogLevel values.
+const (
+	LogLevelUnknown LogLevel = iota
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarning
+	LogLevelError
+)
+
 // LogHandler provides interface to access particular log file.
 type LogHandler interface {
 	Writer() (io.WriteCloser, error)
 	Reader(opt ...LogOption) (io.ReadCloser, error)
 }
+
+// LogEvent is a single line of the service log.
+type LogEvent struct {
+	Time    time.Time
+	Service string
+	Msg     string
+}
+
+// LogSender sends the service logs to a remote destination.
+type LogSender interface {
+	// Send sends the event, it returns an error if the event was not delivered.
+	Send(ctx context.Context, e *LogEvent) error
+	// Close closes the connection to the destination.
+	Close() error
+}
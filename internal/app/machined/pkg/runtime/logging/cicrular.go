@@ -7,31 +7,95 @@ package logging
 import (
 	"fmt"
 	"io"
+	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
-	"github.com/talos-systems/talos/internal/pkg/circular"
-	"github.com/talos-systems/talos/pkg/tail"
 )
 
-// These constants should some day move to config.
+// Buffer capacity defaults.
 const (
 	// Some logs are tiny, no need to reserve too much memory.
 	InitialCapacity = 16384
-	// Cap each log at 1M.
+	// Cap each log at 1M by default, see SetMaxCapacity.
 	MaxCapacity = 1048576
 	// Safety gap to avoid buffer overruns.
 	SafetyGap = 2048
 )
 
 // CircularBufferLoggingManager implements logging to circular fixed size buffer.
+//
+// Lines written to the buffers are indexed by time and level, see runtime.WithSince and runtime.WithLevel.
 type CircularBufferLoggingManager struct {
-	buffers sync.Map
+	buffers     sync.Map
+	maxCapacity int64
+	json        int32
+
+	forwardersMu sync.Mutex
+	forwarders   []*forwarder
 }
 
 // NewCircularBufferLoggingManager initializes new CircularBufferLoggingManager.
 func NewCircularBufferLoggingManager() *CircularBufferLoggingManager {
-	return &CircularBufferLoggingManager{}
+	return &CircularBufferLoggingManager{
+		maxCapacity: MaxCapacity,
+	}
+}
+
+// SetMaxCapacity sets the maximum capacity of the buffers.
+//
+// Buffers which were already created keep their capacity.
+func (manager *CircularBufferLoggingManager) SetMaxCapacity(capacity int) {
+	atomic.StoreInt64(&manager.maxCapacity, int64(capacity))
+}
+
+// SetJSON enables wrapping each line of the logs written afterwards into a JSON record.
+func (manager *CircularBufferLoggingManager) SetJSON(enabled bool) {
+	var v int32
+
+	if enabled {
+		v = 1
+	}
+
+	atomic.StoreInt32(&manager.json, v)
+}
+
+// SetSenders replaces the senders which the logs written afterwards are forwarded to.
+func (manager *CircularBufferLoggingManager) SetSenders(senders []runtime.LogSender) {
+	forwarders := make([]*forwarder, len(senders))
+
+	for i, sender := range senders {
+		forwarders[i] = newForwarder(sender)
+	}
+
+	manager.forwardersMu.Lock()
+	prev := manager.forwarders
+	manager.forwarders = forwarders
+	manager.forwardersMu.Unlock()
+
+	for _, f := range prev {
+		if err := f.stop(); err != nil {
+			log.Printf("error closing log sender: %s", err)
+		}
+	}
+}
+
+func (manager *CircularBufferLoggingManager) forward(e *runtime.LogEvent) {
+	manager.forwardersMu.Lock()
+	defer manager.forwardersMu.Unlock()
+
+	for _, f := range manager.forwarders {
+		f.enqueue(e)
+	}
+}
+
+func (manager *CircularBufferLoggingManager) hasForwarders() bool {
+	manager.forwardersMu.Lock()
+	defer manager.forwardersMu.Unlock()
+
+	return len(manager.forwarders) > 0
 }
 
 // ServiceLog implements runtime.LoggingManager interface.
@@ -42,17 +106,14 @@ func (manager *CircularBufferLoggingManager) ServiceLog(id string) runtime.LogHa
 	}
 }
 
-func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (*circular.Buffer, error) {
+func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (*indexedBuffer, error) {
 	buf, ok := manager.buffers.Load(id)
 	if !ok {
 		if !create {
 			return nil, nil
 		}
 
-		b, err := circular.NewBuffer(
-			circular.WithInitialCapacity(InitialCapacity),
-			circular.WithMaxCapacity(MaxCapacity),
-			circular.WithSafetyGap(SafetyGap))
+		b, err := newIndexedBuffer(int(atomic.LoadInt64(&manager.maxCapacity)))
 		if err != nil {
 			return nil, err // only configuration issue might raise error
 		}
@@ -60,14 +121,14 @@ func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (
 		buf, _ = manager.buffers.LoadOrStore(id, b)
 	}
 
-	return buf.(*circular.Buffer), nil
+	return buf.(*indexedBuffer), nil
 }
 
 type circularHandler struct {
 	manager *CircularBufferLoggingManager
 	id      string
 
-	buf *circular.Buffer
+	buf *indexedBuffer
 }
 
 type nopCloser struct {
@@ -78,6 +139,33 @@ func (c nopCloser) Close() error {
 	return nil
 }
 
+// maxForwardedLineLength limits the size of the forwarded messages.
+//
+// Longer lines are forwarded in chunks, which keeps the messages within the default limits
+// of the syslog servers and bounds the memory held by the forwarder queues.
+const maxForwardedLineLength = 8 * 1024
+
+// teeWriter writes to the log buffer and forwards the lines to the senders.
+type teeWriter struct {
+	buf     io.WriteCloser
+	forward *lineWriter
+}
+
+func (w *teeWriter) Write(p []byte) (int, error) {
+	n, err := w.buf.Write(p)
+	if err != nil {
+		return n, err
+	}
+
+	return w.forward.Write(p)
+}
+
+func (w *teeWriter) Close() error {
+	w.forward.Close() //nolint:errcheck
+
+	return w.buf.Close()
+}
+
 // Writer implements runtime.LogHandler interface.
 func (handler *circularHandler) Writer() (io.WriteCloser, error) {
 	if handler.buf == nil {
@@ -90,7 +178,28 @@ func (handler *circularHandler) Writer() (io.WriteCloser, error) {
 		}
 	}
 
-	return nopCloser{handler.buf}, nil
+	var w io.WriteCloser = nopCloser{handler.buf}
+
+	if atomic.LoadInt32(&handler.manager.json) == 1 {
+		w = newJSONWriter(handler.buf, handler.id)
+	}
+
+	if handler.manager.hasForwarders() {
+		w = &teeWriter{
+			buf: w,
+			forward: newLineWriter(maxForwardedLineLength, func(line []byte) error {
+				handler.manager.forward(&runtime.LogEvent{
+					Time:    time.Now(),
+					Service: handler.id,
+					Msg:     string(line),
+				})
+
+				return nil
+			}),
+		}
+	}
+
+	return w, nil
 }
 
 // Reader implements runtime.LogHandler interface.
@@ -118,25 +227,5 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 		}
 	}
 
-	var r interface {
-		io.ReadCloser
-		io.Seeker
-	}
-
-	if opt.Follow {
-		r = handler.buf.GetStreamingReader()
-	} else {
-		r = handler.buf.GetReader()
-	}
-
-	if opt.TailLines != nil {
-		err := tail.SeekLines(r, *opt.TailLines)
-		if err != nil {
-			r.Close() //nolint:errcheck
-
-			return nil, fmt.Errorf("error tailing log: %w", err)
-		}
-	}
-
-	return r, nil
+	return handler.buf.Reader(opt)
 }
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func TestCircularBufferJSON(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+	manager.SetJSON(true)
+
+	w, err := manager.ServiceLog("test").Writer()
+	require.NoError(t, err)
+
+	_, err = io.WriteString(w, "first line\nsecond")
+	require.NoError(t, err)
+
+	_, err = io.WriteString(w, " line\r\n\"quoted\"")
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	r, err := manager.ServiceLog("test").Reader()
+	require.NoError(t, err)
+
+	defer r.Close() //nolint:errcheck
+
+	var messages []string
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		var record struct {
+			Time    string `json:"ts"`
+			Service string `json:"service"`
+			Msg     string `json:"msg"`
+		}
+
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		assert.Equal(t, "test", record.Service)
+		assert.NotEmpty(t, record.Time)
+
+		messages = append(messages, record.Msg)
+	}
+
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []string{"first line", "second line", `"quoted"`}, messages)
+}
@@ -18,6 +18,9 @@ import (
 )
 
 // FileLoggingManager implements simple logging to files.
+//
+// Log files are not indexed, so reading by time is not supported,
+// and filtering by level scans the whole file.
 type FileLoggingManager struct {
 	logDirectory string
 }
@@ -73,6 +76,10 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	if !opt.Since.IsZero() {
+		return nil, fmt.Errorf("log %q doesn't support reading by time", handler.id)
+	}
+
 	if err := handler.buildPath(); err != nil {
 		return nil, err
 	}
@@ -91,9 +98,17 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	var r io.ReadCloser = f
+
 	if opt.Follow {
-		return follow.NewReader(context.Background(), f), nil
+		r = follow.NewReader(context.Background(), f)
+	}
+
+	if opt.Level != runtime.LogLevelUnknown {
+		r = newLevelReader(r, 0, opt.Level, func(_ int64, line []byte) runtime.LogLevel {
+			return parseLevel(line)
+		})
 	}
 
-	return f, nil
+	return r, nil
 }
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// Number of events buffered for each sender.
+	forwarderQueueSize = 4096
+	// Delays between the attempts to send an event.
+	forwarderMinBackoff = time.Second
+	forwarderMaxBackoff = 30 * time.Second
+)
+
+// forwarder buffers log events and sends them with the sender, retrying on errors.
+type forwarder struct {
+	sender runtime.LogSender
+	queue  chan *runtime.LogEvent
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newForwarder(sender runtime.LogSender) *forwarder {
+	f := &forwarder{
+		sender: sender,
+		queue:  make(chan *runtime.LogEvent, forwarderQueueSize),
+	}
+
+	f.ctx, f.cancel = context.WithCancel(context.Background())
+
+	f.wg.Add(1)
+
+	go f.run()
+
+	return f
+}
+
+// enqueue never blocks, the oldest event is dropped if the queue is full.
+func (f *forwarder) enqueue(e *runtime.LogEvent) {
+	for {
+		select {
+		case f.queue <- e:
+			return
+		default:
+		}
+
+		select {
+		case <-f.queue:
+		default:
+		}
+	}
+}
+
+func (f *forwarder) run() {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case e := <-f.queue:
+			if !f.send(e) {
+				return
+			}
+		}
+	}
+}
+
+// send retries until the event is sent, it returns false if the forwarder is stopped.
+func (f *forwarder) send(e *runtime.LogEvent) bool {
+	backoff := forwarderMinBackoff
+
+	for {
+		if err := f.sender.Send(f.ctx, e); err == nil {
+			return true
+		}
+
+		select {
+		case <-f.ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > forwarderMaxBackoff {
+			backoff = forwarderMaxBackoff
+		}
+	}
+}
+
+func (f *forwarder) stop() error {
+	f.cancel()
+	f.wg.Wait()
+
+	return f.sender.Close()
+}
@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/circular"
+	"github.com/talos-systems/talos/pkg/tail"
+)
+
+// indexEntry points to a complete line in the log buffer.
+type indexEntry struct {
+	ts    int64
+	off   int64
+	level runtime.LogLevel
+}
+
+// indexedBuffer is a circular log buffer which keeps an index of the lines written.
+//
+// The index is used to seek to the lines by time or from the tail without scanning the buffer
+// and to filter the lines by level.
+type indexedBuffer struct {
+	buf *circular.Buffer
+
+	// size of the buffer part available for reading
+	window int64
+
+	// mu serializes writes, so that the index always matches the buffer contents
+	mu sync.Mutex
+
+	// entries are ordered by offset (and time), entries for the overwritten lines are dropped
+	entries []indexEntry
+
+	// current incomplete line
+	lineOpen bool
+	current  indexEntry
+	line     []byte
+}
+
+func newIndexedBuffer(maxCapacity int) (*indexedBuffer, error) {
+	buf, err := circular.NewBuffer(
+		circular.WithInitialCapacity(InitialCapacity),
+		circular.WithMaxCapacity(maxCapacity),
+		circular.WithSafetyGap(SafetyGap))
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexedBuffer{
+		buf:    buf,
+		window: int64(maxCapacity - SafetyGap),
+	}, nil
+}
+
+// Write implements io.Writer.
+func (b *indexedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	off := b.buf.Offset()
+
+	n, err := b.buf.Write(p)
+
+	now := time.Now().UnixNano()
+
+	for i := 0; i < n; {
+		if !b.lineOpen {
+			b.lineOpen = true
+			b.current = indexEntry{ts: now, off: off + int64(i)}
+			b.line = b.line[:0]
+		}
+
+		end := n
+
+		j := bytes.IndexByte(p[i:n], '\n')
+		if j >= 0 {
+			end = i + j
+		}
+
+		// level is detected by the line prefix
+		if room := maxLevelPrefix - len(b.line); room > 0 {
+			chunk := p[i:end]
+			if len(chunk) > room {
+				chunk = chunk[:room]
+			}
+
+			b.line = append(b.line, chunk...)
+		}
+
+		if j < 0 {
+			break
+		}
+
+		b.current.level = parseLevel(b.line)
+		b.entries = append(b.entries, b.current)
+		b.lineOpen = false
+
+		i = end + 1
+	}
+
+	// drop the entries for the lines which are no longer available
+	start := b.buf.Offset() - b.window
+
+	if i := sort.Search(len(b.entries), func(i int) bool { return b.entries[i].off >= start }); i > 0 {
+		b.entries = b.entries[i:]
+	}
+
+	return n, err
+}
+
+// Reader returns the buffer reader positioned according to the options.
+//
+//nolint:gocyclo
+func (b *indexedBuffer) Reader(opt runtime.LogOptions) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var r interface {
+		io.ReadCloser
+		io.Seeker
+		StartOffset() int64
+	}
+
+	if opt.Follow {
+		r = b.buf.GetStreamingReader()
+	} else {
+		r = b.buf.GetReader()
+	}
+
+	off := r.StartOffset()
+
+	if !opt.Since.IsZero() {
+		if sinceOff := b.since(opt.Since); sinceOff > off {
+			off = sinceOff
+		}
+	}
+
+	if opt.TailLines != nil {
+		tailOff, ok := b.tail(*opt.TailLines)
+		if !ok {
+			// not enough lines in the index, fall back to scanning the buffer
+			if err := tail.SeekLines(r, *opt.TailLines); err != nil {
+				r.Close() //nolint:errcheck
+
+				return nil, fmt.Errorf("error tailing log: %w", err)
+			}
+
+			pos, err := r.Seek(0, io.SeekCurrent)
+			if err != nil {
+				r.Close() //nolint:errcheck
+
+				return nil, err
+			}
+
+			tailOff = r.StartOffset() + pos
+		}
+
+		if tailOff > off {
+			off = tailOff
+		}
+	}
+
+	if _, err := r.Seek(off-r.StartOffset(), io.SeekStart); err != nil {
+		r.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error seeking log: %w", err)
+	}
+
+	if opt.Level != runtime.LogLevelUnknown {
+		return newLevelReader(r, off, opt.Level, b.level), nil
+	}
+
+	return r, nil
+}
+
+// since returns the offset of the first line written at or after the time.
+func (b *indexedBuffer) since(t time.Time) int64 {
+	ts := t.UnixNano()
+
+	if i := sort.Search(len(b.entries), func(i int) bool { return b.entries[i].ts >= ts }); i < len(b.entries) {
+		return b.entries[i].off
+	}
+
+	if b.lineOpen && b.current.ts >= ts {
+		return b.current.off
+	}
+
+	return b.buf.Offset()
+}
+
+// tail returns the offset of the n-th line from the end.
+//
+// The last line is counted even if it is not complete, which matches tail.SeekLines.
+func (b *indexedBuffer) tail(n int) (int64, bool) {
+	if n <= 0 {
+		return b.buf.Offset(), true
+	}
+
+	if b.lineOpen {
+		if n == 1 {
+			return b.current.off, true
+		}
+
+		n--
+	}
+
+	if n > len(b.entries) {
+		return 0, false
+	}
+
+	return b.entries[len(b.entries)-n].off, true
+}
+
+// level returns the level of the complete line at the offset.
+func (b *indexedBuffer) level(off int64, _ []byte) runtime.LogLevel {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	i := sort.Search(len(b.entries), func(i int) bool { return b.entries[i].off >= off })
+	if i < len(b.entries) && b.entries[i].off == off {
+		return b.entries[i].level
+	}
+
+	return runtime.LogLevelUnknown
+}
@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func readLog(t *testing.T, handler runtime.LogHandler, opts ...runtime.LogOption) string {
+	r, err := handler.Reader(opts...)
+	require.NoError(t, err)
+
+	defer r.Close() //nolint:errcheck
+
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestCircularBufferSince(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+	handler := manager.ServiceLog("test")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	_, err = io.WriteString(w, "first\nsecond\n")
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	since := time.Now()
+
+	_, err = io.WriteString(w, "third\nfour")
+	require.NoError(t, err)
+
+	assert.Equal(t, "third\nfour", readLog(t, handler, runtime.WithSince(since)))
+	assert.Equal(t, "", readLog(t, handler, runtime.WithSince(time.Now().Add(time.Hour))))
+	assert.Equal(t, "first\nsecond\nthird\nfour", readLog(t, handler, runtime.WithSince(since.Add(-time.Hour))))
+
+	// both since and tail are applied
+	assert.Equal(t, "four", readLog(t, handler, runtime.WithSince(since), runtime.WithTailLines(1)))
+	assert.Equal(t, "third\nfour", readLog(t, handler, runtime.WithSince(since), runtime.WithTailLines(3)))
+}
+
+func TestCircularBufferTail(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+	manager.SetMaxCapacity(logging.InitialCapacity)
+
+	handler := manager.ServiceLog("test")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	// overflow the buffer, so that the first lines are overwritten
+	for i := 0; i < 2000; i++ {
+		_, err = fmt.Fprintf(w, "line %d\n", i)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "line 1998\nline 1999\n", readLog(t, handler, runtime.WithTailLines(2)))
+	assert.Equal(t, "", readLog(t, handler, runtime.WithTailLines(0)))
+
+	// more lines than available
+	all := readLog(t, handler, runtime.WithTailLines(5000))
+	assert.True(t, strings.HasSuffix(all, "line 1999\n"))
+	assert.Less(t, len(all), logging.InitialCapacity)
+}
+
+func TestCircularBufferLevel(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+	handler := manager.ServiceLog("test")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	_, err = io.WriteString(w, strings.Join([]string{
+		`time="2021-06-12T10:00:00Z" level=info msg="starting"`,
+		`{"level":"debug","msg":"details"}`,
+		`W0612 10:00:00.000000       1 reflector.go:424] watch closed`,
+		`no level here`,
+		`{"level":"error","msg":"failed"}`,
+		`time="2021-06-12T10:00:01Z" level=error msg="unfinished"`,
+	}, "\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"W0612 10:00:00.000000       1 reflector.go:424] watch closed\n{\"level\":\"error\",\"msg\":\"failed\"}\n",
+		readLog(t, handler, runtime.WithLevel(runtime.LogLevelWarning)),
+	)
+
+	assert.Equal(t,
+		"{\"level\":\"error\",\"msg\":\"failed\"}\n",
+		readLog(t, handler, runtime.WithLevel(runtime.LogLevelError), runtime.WithTailLines(3)),
+	)
+
+	require.NoError(t, w.Close())
+}
+
+func TestCircularBufferLevelJSON(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+	manager.SetJSON(true)
+
+	handler := manager.ServiceLog("test")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	_, err = io.WriteString(w, "level=info msg=\"starting\"\n{\"level\":\"error\"}\nE0612 10:00:00.000000 failed\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, strings.Count(readLog(t, handler, runtime.WithLevel(runtime.LogLevelError)), "\n"))
+	assert.Equal(t, 3, strings.Count(readLog(t, handler, runtime.WithLevel(runtime.LogLevelInfo)), "\n"))
+}
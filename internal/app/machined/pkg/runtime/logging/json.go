@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type jsonRecord struct {
+	Time    string `json:"ts"`
+	Service string `json:"service"`
+	Msg     string `json:"msg"`
+}
+
+// newJSONWriter wraps each line written into a JSON record.
+func newJSONWriter(w io.Writer, id string) *lineWriter {
+	return newLineWriter(maxLineLength, func(line []byte) error {
+		record, err := json.Marshal(jsonRecord{
+			Time:    time.Now().UTC().Format(time.RFC3339Nano),
+			Service: id,
+			Msg:     string(line),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(append(record, '\n'))
+
+		return err
+	})
+}
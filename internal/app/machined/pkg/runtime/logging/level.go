@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+// maxLevelPrefix is the length of the line prefix the level is looked up in.
+const maxLevelPrefix = 1024
+
+// levelPatterns match the level of the line in the first capture group.
+var levelPatterns = []*regexp.Regexp{
+	// logfmt: level=error
+	regexp.MustCompile(`\blevel=(\w+)`),
+	// JSON: "level":"error", quotes are escaped if the line was wrapped into a JSON record
+	regexp.MustCompile(`\\?"(?:level|severity)\\?"\s*:\s*\\?"(\w+)`),
+	// klog: E0612 10:00:00.000000
+	regexp.MustCompile(`(?:^|"msg":")([IWEF])\d{4} `),
+}
+
+// parseLevel detects the level of the log line.
+func parseLevel(line []byte) runtime.LogLevel {
+	for _, re := range levelPatterns {
+		match := re.FindSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		switch strings.ToLower(string(match[1])) {
+		case "trace", "debug":
+			return runtime.LogLevelDebug
+		case "i", "info", "notice":
+			return runtime.LogLevelInfo
+		case "w", "warn", "warning":
+			return runtime.LogLevelWarning
+		case "e", "f", "err", "error", "crit", "critical", "fatal", "panic":
+			return runtime.LogLevelError
+		}
+	}
+
+	return runtime.LogLevelUnknown
+}
+
+// levelReader skips the lines below the level.
+type levelReader struct {
+	r      *bufio.Reader
+	closer io.Closer
+
+	off     int64
+	min     runtime.LogLevel
+	level   func(off int64, line []byte) runtime.LogLevel
+	pending []byte
+}
+
+// newLevelReader wraps the reader positioned at the offset off.
+//
+// The level function returns the level of the line at the offset.
+func newLevelReader(r io.ReadCloser, off int64, min runtime.LogLevel, level func(off int64, line []byte) runtime.LogLevel) *levelReader {
+	return &levelReader{
+		r:      bufio.NewReader(r),
+		closer: r,
+		off:    off,
+		min:    min,
+		level:  level,
+	}
+}
+
+// Read implements io.Reader.
+func (r *levelReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		line, err := r.r.ReadBytes('\n')
+
+		off := r.off
+		r.off += int64(len(line))
+
+		if len(line) > 0 && r.level(off, line) >= r.min {
+			r.pending = line
+		}
+
+		if err != nil {
+			if len(r.pending) == 0 {
+				return 0, err
+			}
+
+			break
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+// Close implements io.Closer.
+func (r *levelReader) Close() error {
+	return r.closer.Close()
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxLineLength is the default limit on the length of the line passed to emit.
+const maxLineLength = 64 * 1024
+
+// lineWriter calls emit for each line written.
+//
+// Incomplete lines are buffered until the newline or Close,
+// lines longer than maxLen are split into chunks of maxLen bytes.
+type lineWriter struct {
+	mu sync.Mutex
+
+	emit   func(line []byte) error
+	buf    []byte
+	maxLen int
+}
+
+func newLineWriter(maxLen int, emit func(line []byte) error) *lineWriter {
+	return &lineWriter{
+		emit:   emit,
+		maxLen: maxLen,
+	}
+}
+
+// Write implements io.Writer.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+
+		switch {
+		case i >= 0 && i <= w.maxLen:
+			if err := w.emit(bytes.TrimSuffix(w.buf[:i], []byte{'\r'})); err != nil {
+				return 0, err
+			}
+
+			w.buf = w.buf[i+1:]
+		case len(w.buf) > w.maxLen:
+			// don't buffer the line indefinitely, flush it in chunks
+			if err := w.emit(w.buf[:w.maxLen]); err != nil {
+				return 0, err
+			}
+
+			w.buf = w.buf[w.maxLen:]
+		default:
+			// avoid holding on to the lines already written
+			w.buf = append([]byte(nil), w.buf...)
+
+			return len(p), nil
+		}
+	}
+}
+
+// Close implements io.Closer.
+func (w *lineWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	err := w.emit(w.buf)
+	w.buf = nil
+
+	return err
+}
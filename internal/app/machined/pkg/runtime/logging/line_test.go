@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package logging
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineWriter(t *testing.T) {
+	var lines []string
+
+	w := newLineWriter(8, func(line []byte) error {
+		lines = append(lines, string(line))
+
+		return nil
+	})
+
+	_, err := io.WriteString(w, "short\r\n12345678")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"short"}, lines)
+
+	// line longer than the limit is flushed without waiting for the newline
+	_, err = io.WriteString(w, "9abcdefghi")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"short", "12345678", "9abcdefg"}, lines)
+	assert.Equal(t, "hi", string(w.buf))
+
+	_, err = io.WriteString(w, "jk\nlast")
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, []string{"short", "12345678", "9abcdefg", "hijk", "last"}, lines)
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// daemon facility, informational severity.
+	syslogPriority = 3*8 + 6
+
+	syslogWriteTimeout = 10 * time.Second
+)
+
+// SyslogSender sends the logs to a syslog server over TCP or TLS.
+//
+// Messages are formatted according to RFC 5424 and framed with the octet counting (RFC 6587).
+type SyslogSender struct {
+	endpoint *url.URL
+	conn     net.Conn
+}
+
+// NewSyslogSender initializes new SyslogSender.
+//
+// Endpoint scheme should be either tcp or tls.
+func NewSyslogSender(endpoint *url.URL) *SyslogSender {
+	return &SyslogSender{
+		endpoint: endpoint,
+	}
+}
+
+// Send implements runtime.LogSender interface.
+func (s *SyslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err != nil {
+		return s.reset(err)
+	}
+
+	if _, err := s.conn.Write(formatSyslog(e)); err != nil {
+		return s.reset(err)
+	}
+
+	return nil
+}
+
+// Close implements runtime.LogSender interface.
+func (s *SyslogSender) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+func (s *SyslogSender) dial(ctx context.Context) (net.Conn, error) {
+	switch s.endpoint.Scheme {
+	case "tcp":
+		var d net.Dialer
+
+		return d.DialContext(ctx, "tcp", s.endpoint.Host)
+	case "tls":
+		d := tls.Dialer{
+			Config: &tls.Config{
+				ServerName: s.endpoint.Hostname(),
+			},
+		}
+
+		return d.DialContext(ctx, "tcp", s.endpoint.Host)
+	default:
+		return nil, fmt.Errorf("unsupported syslog endpoint scheme %q", s.endpoint.Scheme)
+	}
+}
+
+// reset drops the connection, so that the next Send reconnects.
+func (s *SyslogSender) reset(err error) error {
+	s.Close() //nolint:errcheck
+
+	return err
+}
+
+func formatSyslog(e *runtime.LogEvent) []byte {
+	hostname, _ := os.Hostname() //nolint:errcheck
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	appName := e.Service
+	if appName == "" {
+		appName = "-"
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s - - - %s", syslogPriority, e.Time.UTC().Format(time.RFC3339Nano), hostname, appName, e.Msg)
+
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+// readSyslog reads a message framed with the octet counting.
+func readSyslog(r *bufio.Reader) (string, error) {
+	length, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+
+	n, err := strconv.Atoi(length[:len(length)-1])
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, n)
+
+	_, err = io.ReadFull(r, msg)
+
+	return string(msg), err
+}
+
+func TestSyslogForwarding(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer l.Close() //nolint:errcheck
+
+	manager := logging.NewCircularBufferLoggingManager()
+	manager.SetSenders([]runtime.LogSender{
+		logging.NewSyslogSender(&url.URL{Scheme: "tcp", Host: l.Addr().String()}),
+	})
+
+	defer manager.SetSenders(nil)
+
+	w, err := manager.ServiceLog("test").Writer()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = fmt.Fprintf(w, "line %d\n", i)
+		require.NoError(t, err)
+	}
+
+	// long lines are forwarded in chunks of 8 KiB
+	_, err = io.WriteString(w, strings.Repeat("x", 10000)+"\n")
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	conn, err := l.Accept()
+	require.NoError(t, err)
+
+	defer conn.Close() //nolint:errcheck
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+
+	r := bufio.NewReader(conn)
+
+	for i := 0; i < 3; i++ {
+		msg, err := readSyslog(r)
+		require.NoError(t, err)
+
+		assert.Regexp(t, regexp.MustCompile(fmt.Sprintf(`^<30>1 \S+ \S+ test - - - line %d$`, i)), msg)
+	}
+
+	for _, n := range []int{8192, 10000 - 8192} {
+		msg, err := readSyslog(r)
+		require.NoError(t, err)
+
+		assert.Regexp(t, regexp.MustCompile(`^<30>1 \S+ \S+ test - - - x`), msg)
+		assert.True(t, strings.HasSuffix(msg, " "+strings.Repeat("x", n)), "unexpected message length %d", len(msg))
+	}
+
+	// logs are still kept locally
+	rd, err := manager.ServiceLog("test").Reader()
+	require.NoError(t, err)
+
+	defer rd.Close() //nolint:errcheck
+
+	local, err := io.ReadAll(rd)
+	require.NoError(t, err)
+
+	assert.Equal(t, "line 0\nline 1\nline 2\n"+strings.Repeat("x", 10000)+"\n", string(local))
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package metal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/download"
+)
+
+func TestFetchFirstConfiguration(t *testing.T) {
+	var failedRequests int
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failedRequests++
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer failing.Close()
+
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("version: v1alpha1\n")) //nolint:errcheck
+	}))
+
+	defer working.Close()
+
+	opts := []download.Option{download.WithTimeout(time.Second)}
+
+	b, err := fetchFirstConfiguration(context.Background(), []string{failing.URL, working.URL}, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "version: v1alpha1\n", string(b))
+	assert.Positive(t, failedRequests)
+
+	// the first working source wins
+	b, err = fetchFirstConfiguration(context.Background(), []string{working.URL, failing.URL}, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "version: v1alpha1\n", string(b))
+
+	_, err = fetchFirstConfiguration(context.Background(), []string{failing.URL, failing.URL + "/other"}, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 errors occurred")
+}
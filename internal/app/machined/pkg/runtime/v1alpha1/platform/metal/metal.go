@@ -9,16 +9,21 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net"
 	"net/url"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 	"github.com/talos-systems/go-procfs/procfs"
 	"github.com/talos-systems/go-smbios/smbios"
+	"golang.org/x/net/http/httpproxy"
 	"golang.org/x/sys/unix"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -29,6 +34,10 @@ import (
 
 const (
 	mnt = "/mnt"
+
+	// sourceTimeout limits the time spent retrying each source if `talos.config` is specified multiple times,
+	// so that an unreachable source doesn't delay the fallback to the next one.
+	sourceTimeout = 30 * time.Second
 )
 
 // Metal is a discoverer for non-cloud environments.
@@ -40,9 +49,13 @@ func (m *Metal) Name() string {
 }
 
 // Configuration implements the platform.Platform interface.
+//
+// If `talos.config` is specified multiple times, the sources are tried in order until the config is fetched.
 func (m *Metal) Configuration(ctx context.Context) ([]byte, error) {
+	param := procfs.ProcCmdline().Get(constants.KernelParamConfig)
+
 	var option *string
-	if option = procfs.ProcCmdline().Get(constants.KernelParamConfig).First(); option == nil {
+	if option = param.First(); option == nil {
 		return nil, errors.ErrNoConfigSource
 	}
 
@@ -50,9 +63,50 @@ func (m *Metal) Configuration(ctx context.Context) ([]byte, error) {
 		return nil, errors.ErrNoConfigSource
 	}
 
-	log.Printf("fetching machine config from: %q", *option)
+	opts, err := downloadOptions(procfs.ProcCmdline())
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []string
+
+	for i := 0; param.Get(i) != nil; i++ {
+		sources = append(sources, *param.Get(i))
+	}
+
+	if len(sources) == 1 {
+		return fetchConfiguration(ctx, sources[0], opts)
+	}
+
+	return fetchFirstConfiguration(ctx, sources, append(opts, download.WithTimeout(sourceTimeout)))
+}
+
+// fetchFirstConfiguration returns the config from the first source which can be fetched.
+func fetchFirstConfiguration(ctx context.Context, sources []string, opts []download.Option) ([]byte, error) {
+	var result *multierror.Error
+
+	for _, source := range sources {
+		b, err := fetchConfiguration(ctx, source, opts)
+		if err == nil {
+			return b, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		log.Printf("failed to fetch machine config from %q: %s", source, err)
+
+		result = multierror.Append(result, err)
+	}
+
+	return nil, result.ErrorOrNil()
+}
 
-	downloadURL, err := PopulateURLParameters(*option, getSystemUUID)
+func fetchConfiguration(ctx context.Context, option string, opts []download.Option) ([]byte, error) {
+	log.Printf("fetching machine config from: %q", option)
+
+	downloadURL, err := PopulateURLParameters(option, getSystemUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +115,41 @@ func (m *Metal) Configuration(ctx context.Context) ([]byte, error) {
 	case constants.MetalConfigISOLabel:
 		return readConfigFromISO()
 	default:
-		return download.Download(ctx, downloadURL)
+		return download.Download(ctx, downloadURL, opts...)
+	}
+}
+
+// downloadOptions builds the machine config download options from the kernel parameters.
+func downloadOptions(cmdline *procfs.Cmdline) ([]download.Option, error) {
+	var opts []download.Option
+
+	if limit := cmdline.Get(constants.KernelParamDownloadRateLimit).First(); limit != nil {
+		bytesPerSecond, err := humanize.ParseBytes(*limit)
+		if err != nil || bytesPerSecond == 0 || bytesPerSecond > math.MaxInt32 {
+			return nil, fmt.Errorf("invalid %s value %q", constants.KernelParamDownloadRateLimit, *limit)
+		}
+
+		opts = append(opts, download.WithRateLimit(download.NewRateLimiter(int(bytesPerSecond))))
 	}
+
+	if proxy := cmdline.Get(constants.KernelParamDownloadProxy).First(); proxy != nil {
+		if _, err := url.Parse(*proxy); err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", constants.KernelParamDownloadProxy, *proxy, err)
+		}
+
+		proxyConfig := &httpproxy.Config{
+			HTTPProxy:  *proxy,
+			HTTPSProxy: *proxy,
+		}
+
+		if noProxy := cmdline.Get(constants.KernelParamDownloadNoProxy).First(); noProxy != nil {
+			proxyConfig.NoProxy = *noProxy
+		}
+
+		opts = append(opts, download.WithProxy(proxyConfig))
+	}
+
+	return opts, nil
 }
 
 // PopulateURLParameters fills in empty parameters in the download URL.
@@ -7,15 +7,14 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
-	"reflect"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
-	"github.com/google/go-cmp/cmp"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configdiff"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
-	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/resources/k8s"
 )
 
@@ -82,40 +81,27 @@ func (r *Runtime) CanApplyImmediate(b []byte) error {
 		return fmt.Errorf("error serializing current config: %w", err)
 	}
 
-	currentConfigProvider, err := configloader.NewFromBytes(currentBytes)
+	currentConfig, err := configloader.NewFromBytes(currentBytes)
 	if err != nil {
 		return fmt.Errorf("error loading current config: %w", err)
 	}
 
-	currentConfig, ok := currentConfigProvider.(*v1alpha1.Config)
-	if !ok {
-		return fmt.Errorf("current config is not v1alpha1")
+	changes, err := configdiff.Diff(currentConfig, cfg)
+	if err != nil {
+		return fmt.Errorf("error comparing configs: %w", err)
 	}
 
-	newConfig, ok := cfg.(*v1alpha1.Config)
-	if !ok {
-		return fmt.Errorf("new config is not v1alpha1")
-	}
+	// the config changes allowed to be applied immediately are listed in configdiff.ImmediatePaths
+	var rebootPaths []string
 
-	// the config changes allowed to be applied immediately are:
-	// * cluster config
-	// * .machine.debug
-	// * .machine.time
-	// * .machine.network
-	// * .machine.certCANs
-	newConfig.ClusterConfig = currentConfig.ClusterConfig
-	newConfig.ConfigDebug = currentConfig.ConfigDebug
-
-	if newConfig.MachineConfig != nil && currentConfig.MachineConfig != nil {
-		newConfig.MachineConfig.MachineTime = currentConfig.MachineConfig.MachineTime
-		newConfig.MachineConfig.MachineCertSANs = currentConfig.MachineConfig.MachineCertSANs
-		newConfig.MachineConfig.MachineNetwork = currentConfig.MachineConfig.MachineNetwork
+	for _, change := range changes {
+		if change.RebootRequired {
+			rebootPaths = append(rebootPaths, change.Path)
+		}
 	}
 
-	if !reflect.DeepEqual(currentConfig, newConfig) {
-		diff := cmp.Diff(currentConfig, newConfig)
-
-		return fmt.Errorf("this config change can't be applied in immediate mode\ndiff: %s", diff)
+	if len(rebootPaths) > 0 {
+		return fmt.Errorf("this config change can't be applied in immediate mode\nchanged paths: %s", strings.Join(rebootPaths, ", "))
 	}
 
 	return nil
@@ -94,6 +94,9 @@ func (*Sequencer) Initialize(r runtime.Runtime) []runtime.Phase {
 		).Append(
 			"config",
 			LoadConfig,
+		).Append(
+			"limits",
+			ConfigureServiceLogs,
 		)
 	default:
 		phases = phases.Append(
@@ -107,10 +110,9 @@ func (*Sequencer) Initialize(r runtime.Runtime) []runtime.Phase {
 			MountBPFFS,
 			MountCgroups,
 			MountPseudoFilesystems,
-			SetRLimit,
 		).Append(
-			"integrity",
-			WriteIMAPolicy,
+			"kspp",
+			VerifyKSPPRequirements,
 		).Append(
 			"etc",
 			CreateOSReleaseFile,
@@ -121,6 +123,13 @@ func (*Sequencer) Initialize(r runtime.Runtime) []runtime.Phase {
 		).Append(
 			"config",
 			LoadConfig,
+		).Append(
+			"limits",
+			SetRLimit,
+			ConfigureServiceLogs,
+		).Append(
+			"integrity",
+			WriteIMAPolicy,
 		).AppendWhen(
 			r.State().Machine().Installed(),
 			"unmountSystem",
@@ -404,6 +413,9 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"stopServices",
 			StopServicesForUpgrade,
+		).Append(
+			"unmountPersistentVolumes",
+			UnmountPersistentVolumePodMounts,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
@@ -449,6 +461,9 @@ func stopAllPhaselist(r runtime.Runtime) PhaseList {
 		phases = phases.Append(
 			"stopEverything",
 			StopAllServices,
+		).Append(
+			"unmountPersistentVolumes",
+			UnmountPersistentVolumePodMounts,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
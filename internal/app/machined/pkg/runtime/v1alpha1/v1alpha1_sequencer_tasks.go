@@ -25,6 +25,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/partition/gpt"
@@ -40,6 +41,7 @@ import (
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/internal/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
@@ -63,6 +65,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/resources/network"
 	resourcev1alpha1 "github.com/talos-systems/talos/pkg/resources/v1alpha1"
 	"github.com/talos-systems/talos/pkg/sysctl"
 	"github.com/talos-systems/talos/pkg/version"
@@ -108,14 +111,27 @@ func SetupLogger(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionF
 // EnforceKSPPRequirements represents the EnforceKSPPRequirements task.
 func EnforceKSPPRequirements(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		if err = kspp.EnforceKSPPKernelParameters(); err != nil {
-			return err
-		}
-
 		return kspp.EnforceKSPPSysctls()
 	}, "enforceKSPPRequirements"
 }
 
+// VerifyKSPPRequirements represents the VerifyKSPPRequirements task.
+func VerifyKSPPRequirements(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		report := kspp.Verify()
+
+		for _, req := range report {
+			logger.Print(req)
+		}
+
+		if err = report.Err(); err != nil {
+			return fmt.Errorf("%d of %d KSPP requirements are not met: %w", len(report.Failed()), len(report), err)
+		}
+
+		return nil
+	}, "verifyKSPPRequirements"
+}
+
 // SetupSystemDirectory represents the SetupSystemDirectory task.
 func SetupSystemDirectory(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -246,13 +262,65 @@ func WriteRequiredSysctls(seq runtime.Sequence, data interface{}) (runtime.TaskE
 // SetRLimit represents the SetRLimit task.
 func SetRLimit(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		// TODO(andrewrynhard): Should we read limit from /proc/sys/fs/nr_open?
-		return unix.Setrlimit(unix.RLIMIT_NOFILE, &unix.Rlimit{Cur: 1048576, Max: 1048576})
+		limit := r.Config().Machine().FileLimit()
+
+		nrOpen, err := sysctl.ReadSystemProperty(&sysctl.SystemProperty{Key: "fs.nr_open"})
+		if err != nil {
+			return fmt.Errorf("failed to read fs.nr_open: %w", err)
+		}
+
+		maxLimit, err := strconv.ParseUint(strings.TrimSpace(string(nrOpen)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse fs.nr_open: %w", err)
+		}
+
+		if limit > maxLimit {
+			return fmt.Errorf("file limit %d exceeds the kernel maximum %d (fs.nr_open)", limit, maxLimit)
+		}
+
+		if err = unix.Setrlimit(unix.RLIMIT_NOFILE, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return fmt.Errorf("failed to set file limit: %w", err)
+		}
+
+		var effective unix.Rlimit
+
+		if err = unix.Getrlimit(unix.RLIMIT_NOFILE, &effective); err != nil {
+			return fmt.Errorf("failed to get file limit: %w", err)
+		}
+
+		logger.Printf("file limit set to %d", effective.Cur)
+
+		return nil
 	}, "setRLimit"
 }
 
+// ConfigureServiceLogs represents the ConfigureServiceLogs task.
+func ConfigureServiceLogs(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		manager, ok := r.Logging().(*logging.CircularBufferLoggingManager)
+		if !ok {
+			return nil
+		}
+
+		manager.SetMaxCapacity(int(r.Config().Machine().ServiceLogSize()))
+		manager.SetJSON(r.Config().Machine().ServiceLogFormat() == constants.ServiceLogFormatJSON)
+
+		senders := make([]runtime.LogSender, 0, len(r.Config().Machine().LogDestinations()))
+
+		for _, destination := range r.Config().Machine().LogDestinations() {
+			logger.Printf("forwarding service logs to %s", destination.Endpoint())
+
+			senders = append(senders, logging.NewSyslogSender(destination.Endpoint()))
+		}
+
+		manager.SetSenders(senders)
+
+		return nil
+	}, "configureServiceLogs"
+}
+
 // See https://www.kernel.org/doc/Documentation/ABI/testing/ima_policy
-var rules = []string{
+var imaDontMeasureRules = []string{
 	"dont_measure fsmagic=0x9fa0",     // PROC_SUPER_MAGIC
 	"dont_measure fsmagic=0x62656572", // SYSFS_MAGIC
 	"dont_measure fsmagic=0x64626720", // DEBUGFS_MAGIC
@@ -268,18 +336,46 @@ var rules = []string{
 	"dont_measure fsmagic=0xde5e81e4", // EFIVARFS_MAGIC
 	"dont_measure fsmagic=0x58465342", // XFS_MAGIC
 	"dont_measure fsmagic=0x794c7630", // OVERLAYFS_SUPER_MAGIC
-	"measure func=MMAP_CHECK mask=MAY_EXEC",
-	"measure func=BPRM_CHECK mask=MAY_EXEC",
-	"measure func=FILE_CHECK mask=^MAY_READ euid=0",
-	"measure func=FILE_CHECK mask=^MAY_READ uid=0",
-	"measure func=MODULE_CHECK",
-	"measure func=FIRMWARE_CHECK",
-	"measure func=POLICY_CHECK",
+}
+
+var imaProfiles = map[string][]string{
+	constants.IMAProfileDefault: append(append([]string(nil), imaDontMeasureRules...),
+		"measure func=MMAP_CHECK mask=MAY_EXEC",
+		"measure func=BPRM_CHECK mask=MAY_EXEC",
+		"measure func=FILE_CHECK mask=^MAY_READ euid=0",
+		"measure func=FILE_CHECK mask=^MAY_READ uid=0",
+		"measure func=MODULE_CHECK",
+		"measure func=FIRMWARE_CHECK",
+		"measure func=POLICY_CHECK",
+	),
+	constants.IMAProfileExec: append(append([]string(nil), imaDontMeasureRules...),
+		"measure func=MMAP_CHECK mask=MAY_EXEC",
+		"measure func=BPRM_CHECK mask=MAY_EXEC",
+		"measure func=MODULE_CHECK",
+		"measure func=FIRMWARE_CHECK",
+		"measure func=POLICY_CHECK",
+	),
+	constants.IMAProfileNone: nil,
 }
 
 // WriteIMAPolicy represents the WriteIMAPolicy task.
 func WriteIMAPolicy(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		profile := r.Config().Machine().IMA().Profile()
+
+		profileRules, ok := imaProfiles[profile]
+		if !ok {
+			return fmt.Errorf("unsupported IMA policy profile %q", profile)
+		}
+
+		rules := append(append([]string(nil), profileRules...), r.Config().Machine().IMA().Rules()...)
+
+		if len(rules) == 0 {
+			logger.Printf("IMA policy is empty, skipping")
+
+			return nil
+		}
+
 		if _, err = os.Stat("/sys/kernel/security/ima/policy"); os.IsNotExist(err) {
 			return fmt.Errorf("policy file does not exist: %w", err)
 		}
@@ -291,12 +387,15 @@ func WriteIMAPolicy(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 
 		defer f.Close() //nolint:errcheck
 
+		// kernel validates each rule on write
 		for _, line := range rules {
 			if _, err = f.WriteString(line + "\n"); err != nil {
-				return fmt.Errorf("rule %q is invalid", err)
+				return fmt.Errorf("rule %q is invalid: %w", line, err)
 			}
 		}
 
+		logger.Printf("loaded IMA policy %q with %d rules", profile, len(rules))
+
 		return nil
 	}, "writeIMAPolicy"
 }
@@ -763,6 +862,12 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 				}
 			}
 
+			for _, part := range disk.Partitions() {
+				if err = partition.CheckFileSystemType(part.Filesystem()); err != nil {
+					return fmt.Errorf("error setting up %q: %w", disk.Device(), err)
+				}
+			}
+
 			m.Devices[disk.Device()] = installer.Device{
 				Device:                 disk.Device(),
 				ResetPartitionTable:    true,
@@ -776,10 +881,12 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 						Size:           part.Size(),
 						Force:          true,
 						PartitionType:  partition.LinuxFilesystemData,
-						FileSystemType: partition.FilesystemTypeXFS,
+						FileSystemType: part.Filesystem(),
 					},
 				}
 
+				extraTarget.RelativeSize, extraTarget.RelativeToFree = part.RelativeSize()
+
 				m.Targets[disk.Device()] = append(m.Targets[disk.Device()], extraTarget)
 			}
 
@@ -810,7 +917,7 @@ func mountDisks(r runtime.Runtime) (err error) {
 				}
 			}
 
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
+			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), part.Filesystem(), unix.MS_NOATIME, ""))
 		}
 	}
 
@@ -829,7 +936,7 @@ func unmountDisks(r runtime.Runtime) (err error) {
 				return err
 			}
 
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
+			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), part.Filesystem(), unix.MS_NOATIME, ""))
 		}
 	}
 
@@ -855,9 +962,25 @@ func WriteUserFiles(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 
 		files = append(files, extra...)
 
+		var templateData *userFileTemplateData
+
 		for _, f := range files {
 			content := f.Content()
 
+			if f.Template() {
+				if templateData == nil {
+					if templateData, err = newUserFileTemplateData(ctx, r); err != nil {
+						return fmt.Errorf("error gathering template data: %w", err)
+					}
+				}
+
+				if content, err = renderUserFile(f.Path(), content, templateData); err != nil {
+					result = multierror.Append(result, err)
+
+					continue
+				}
+			}
+
 			switch f.Op() {
 			case "create":
 				// Allow create at all times.
@@ -883,14 +1006,26 @@ func WriteUserFiles(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 					continue
 				}
 
-				content = string(existingFileContents) + "\n" + f.Content()
+				// Skip if the content was already appended, so that re-running the task converges.
+				if strings.Contains(string(existingFileContents), content) {
+					continue
+				}
+
+				content = string(existingFileContents) + "\n" + content
 			default:
 				result = multierror.Append(result, fmt.Errorf("unknown operation for file %q: %q", f.Path(), f.Op()))
 
 				continue
 			}
 
-			if filepath.Dir(f.Path()) == constants.ManifestsDirectory {
+			// Files on the writable overlays are written in place.
+			if isOverlayPath(f.Path()) {
+				if err = os.MkdirAll(filepath.Dir(f.Path()), 0o755); err != nil {
+					result = multierror.Append(result, err)
+
+					continue
+				}
+
 				if err = ioutil.WriteFile(f.Path(), []byte(content), f.Permissions()); err != nil {
 					result = multierror.Append(result, err)
 
@@ -920,10 +1055,9 @@ func WriteUserFiles(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 				inVar = false
 			}
 
-			// We do not want to support creating new files anywhere outside of
-			// /var. If a valid use case comes up, we can reconsider then.
+			// Files can't be created on the read-only rootfs, as there is no bind mount target.
 			if !inVar && f.Op() == "create" {
-				return fmt.Errorf("create operation not allowed outside of /var: %q", f.Path())
+				return fmt.Errorf("create operation not allowed outside of /var and %s: %q", strings.Join(constants.Overlays, ", "), f.Path())
 			}
 
 			if err = os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
@@ -969,6 +1103,82 @@ func doesNotExists(p string) (err error) {
 	return fmt.Errorf("file exists")
 }
 
+// userFileTemplateData is the data available to the templated user files.
+type userFileTemplateData struct {
+	Hostname   string
+	Domainname string
+	FQDN       string
+	IP         string
+	IPs        []string
+}
+
+func newUserFileTemplateData(ctx context.Context, r runtime.Runtime) (*userFileTemplateData, error) {
+	data := &userFileTemplateData{}
+
+	hostname, err := r.State().V1Alpha2().Resources().Get(ctx, network.NewHostnameStatus(network.NamespaceName, network.HostnameID).Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if data.Hostname, err = os.Hostname(); err != nil {
+			return nil, err
+		}
+
+		data.FQDN = data.Hostname
+	} else {
+		spec := hostname.(*network.HostnameStatus).TypedSpec()
+
+		data.Hostname = spec.Hostname
+		data.Domainname = spec.Domainname
+		data.FQDN = spec.FQDN()
+	}
+
+	addresses, err := r.State().V1Alpha2().Resources().Get(ctx, network.NewNodeAddress(network.NamespaceName, network.NodeAddressDefaultID).Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		return data, nil
+	}
+
+	for _, addr := range addresses.(*network.NodeAddress).TypedSpec().Addresses {
+		data.IPs = append(data.IPs, addr.String())
+	}
+
+	if len(data.IPs) > 0 {
+		data.IP = data.IPs[0]
+	}
+
+	return data, nil
+}
+
+func renderUserFile(path, content string, data *userFileTemplateData) (string, error) {
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template for file %q: %w", path, err)
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("error rendering template for file %q: %w", path, err)
+	}
+
+	return buf.String(), nil
+}
+
+func isOverlayPath(p string) bool {
+	for _, dir := range constants.Overlays {
+		if strings.HasPrefix(p, dir+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func existsAndIsFile(p string) (err error) {
 	var info os.FileInfo
 
@@ -1045,20 +1255,168 @@ func UnmountPodMounts(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 
 			mountpoint := fields[1]
 			if strings.HasPrefix(mountpoint, constants.EphemeralMountPoint+"/") {
-				logger.Printf("unmounting %s\n", mountpoint)
+				if err = unmountPodMount(logger, mountpoint); err != nil {
+					return err
+				}
+			}
+		}
 
-				if err = unix.Unmount(mountpoint, 0); err != nil {
-					if errors.Is(err, syscall.EINVAL) {
-						log.Printf("ignoring unmount error %s: %v", mountpoint, err)
-					} else {
-						return fmt.Errorf("error unmounting %s: %w", mountpoint, err)
+		return scanner.Err()
+	}, "unmountPodMounts"
+}
+
+// UnmountPersistentVolumePodMounts represents the UnmountPersistentVolumePodMounts task.
+//
+// Pod mounts backed by the partitions holding persistent volumes are unmounted before the user disks,
+// once the processes of the pod exit (or podTerminationTimeout passes).
+func UnmountPersistentVolumePodMounts(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		var persistentSources map[string]struct{}
+
+		if persistentSources, err = persistentVolumePartitions(r); err != nil {
+			return err
+		}
+
+		if len(persistentSources) == 0 {
+			return nil
+		}
+
+		var b []byte
+
+		if b, err = ioutil.ReadFile("/proc/self/mountinfo"); err != nil {
+			return err
+		}
+
+		rdr := bytes.NewReader(b)
+
+		// pods are waited for once, as a pod might have several persistent volumes
+		waited := map[string]struct{}{}
+
+		scanner := bufio.NewScanner(rdr)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+
+			if len(fields) < 5 {
+				continue
+			}
+
+			mountpoint := fields[4]
+			if !strings.HasPrefix(mountpoint, constants.EphemeralMountPoint+"/") {
+				continue
+			}
+
+			if _, ok := persistentSources[mountSource(fields)]; !ok {
+				continue
+			}
+
+			if podUID := podUIDFromMountPoint(mountpoint); podUID != "" {
+				if _, ok := waited[podUID]; !ok {
+					waited[podUID] = struct{}{}
+
+					if err = waitForPodTermination(ctx, podUID); err != nil {
+						logger.Printf("pod %s didn't terminate, unmounting its persistent volumes anyway: %s", podUID, err)
 					}
 				}
 			}
+
+			if err = unmountPodMount(logger, mountpoint); err != nil {
+				return err
+			}
 		}
 
 		return scanner.Err()
-	}, "unmountPodMounts"
+	}, "unmountPersistentVolumePodMounts"
+}
+
+func unmountPodMount(logger *log.Logger, mountpoint string) error {
+	logger.Printf("unmounting %s\n", mountpoint)
+
+	if err := unix.Unmount(mountpoint, 0); err != nil {
+		if errors.Is(err, syscall.EINVAL) {
+			log.Printf("ignoring unmount error %s: %v", mountpoint, err)
+		} else {
+			return fmt.Errorf("error unmounting %s: %w", mountpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// persistentVolumePartitions returns the device paths of the user disk partitions holding persistent volumes.
+func persistentVolumePartitions(r runtime.Runtime) (map[string]struct{}, error) {
+	partitions := map[string]struct{}{}
+
+	for _, disk := range r.Config().Machine().Disks() {
+		for i, part := range disk.Partitions() {
+			if !part.PersistentVolumes() {
+				continue
+			}
+
+			partname, err := util.PartPath(disk.Device(), i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			partitions[partname] = struct{}{}
+		}
+	}
+
+	return partitions, nil
+}
+
+// mountSource returns the mount source of the /proc/self/mountinfo entry.
+//
+// Mount source follows the filesystem type after the optional fields separator.
+func mountSource(fields []string) string {
+	for i, field := range fields {
+		if field == "-" && i+2 < len(fields) {
+			return fields[i+2]
+		}
+	}
+
+	return ""
+}
+
+// podUIDFromMountPoint returns the UID of the pod the mount point belongs to.
+func podUIDFromMountPoint(mountpoint string) string {
+	if !strings.HasPrefix(mountpoint, constants.KubeletPodsDir+"/") {
+		return ""
+	}
+
+	return strings.SplitN(strings.TrimPrefix(mountpoint, constants.KubeletPodsDir+"/"), "/", 2)[0]
+}
+
+// podTerminationTimeout is the time pod processes are given to exit before the pod mounts are unmounted.
+const podTerminationTimeout = time.Minute
+
+// waitForPodTermination waits for the processes in the cgroup of the pod to exit.
+func waitForPodTermination(ctx context.Context, podUID string) error {
+	// cgroupfs and systemd cgroup drivers name pod cgroups differently
+	cgroups := []string{"pod" + podUID, "pod" + strings.ReplaceAll(podUID, "-", "_")}
+
+	return retry.Constant(podTerminationTimeout, retry.WithUnits(time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		procs, err := filepath.Glob("/proc/[0-9]*/cgroup")
+		if err != nil {
+			return err
+		}
+
+		for _, proc := range procs {
+			var contents []byte
+
+			if contents, err = ioutil.ReadFile(proc); err != nil {
+				// process exited
+				continue
+			}
+
+			for _, cgroup := range cgroups {
+				if bytes.Contains(contents, []byte(cgroup)) {
+					return retry.ExpectedError(fmt.Errorf("pod %s is still running", podUID))
+				}
+			}
+		}
+
+		return nil
+	})
 }
 
 // UnmountSystemDiskBindMounts represents the UnmountSystemDiskBindMounts task.
@@ -1594,6 +1952,7 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 				r.Config().Machine().Registries(),
 				install.WithForce(true),
 				install.WithZero(r.Config().Machine().Install().Zero()),
+				install.WithWipeMode(r.Config().Machine().Install().WipeMode()),
 				install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
 			)
 			if err != nil {
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:scopelint,testpackage
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_mountSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		mountinfo string
+		want      string
+	}{
+		{
+			name:      "no optional fields",
+			mountinfo: "36 35 98:0 /mnt1 /mnt2 rw,noatime - ext3 /dev/root rw,errors=continue",
+			want:      "/dev/root",
+		},
+		{
+			name:      "optional fields",
+			mountinfo: "1234 29 259:5 / /var/lib/kubelet/pods/f5b2a5b8-2b7a-4b8f-a0a4-1f6c8f6b1c0a/volumes/kubernetes.io~local-volume/pv1 rw,relatime shared:21 master:1 - xfs /dev/sdb1 rw,attr2,inode64",
+			want:      "/dev/sdb1",
+		},
+		{
+			name:      "truncated",
+			mountinfo: "36 35 98:0 /mnt1 /mnt2 rw,noatime - ext3",
+			want:      "",
+		},
+		{
+			name:      "no separator",
+			mountinfo: "36 35 98:0 /mnt1 /mnt2 rw,noatime",
+			want:      "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mountSource(strings.Fields(tt.mountinfo)); got != tt.want {
+				t.Errorf("mountSource() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_podUIDFromMountPoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		mountpoint string
+		want       string
+	}{
+		{
+			name:       "volume",
+			mountpoint: "/var/lib/kubelet/pods/f5b2a5b8-2b7a-4b8f-a0a4-1f6c8f6b1c0a/volumes/kubernetes.io~local-volume/pv1",
+			want:       "f5b2a5b8-2b7a-4b8f-a0a4-1f6c8f6b1c0a",
+		},
+		{
+			name:       "pod directory",
+			mountpoint: "/var/lib/kubelet/pods/f5b2a5b8-2b7a-4b8f-a0a4-1f6c8f6b1c0a",
+			want:       "f5b2a5b8-2b7a-4b8f-a0a4-1f6c8f6b1c0a",
+		},
+		{
+			name:       "pods directory",
+			mountpoint: "/var/lib/kubelet/pods",
+			want:       "",
+		},
+		{
+			name:       "not a pod mount",
+			mountpoint: "/var/lib/containerd/io.containerd.grpc.v1.cri/sandboxes/abc/shm",
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podUIDFromMountPoint(tt.mountpoint); got != tt.want {
+				t.Errorf("podUIDFromMountPoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
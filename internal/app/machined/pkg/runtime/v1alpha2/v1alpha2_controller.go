@@ -6,6 +6,8 @@ package v1alpha2
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	osruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
@@ -16,6 +18,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/config"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/files"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
@@ -36,13 +39,22 @@ type Controller struct {
 	logger            *zap.Logger
 
 	v1alpha1Runtime runtime.Runtime
+
+	controllerAdaptersMu sync.Mutex
+	controllerAdapters   map[string]controller.Runtime
+
+	controllerStatuses *controllerStatuses
+	metrics            *controllerMetrics
 }
 
 // NewController creates Controller.
 func NewController(v1alpha1Runtime runtime.Runtime, loggingManager runtime.LoggingManager) (*Controller, error) {
 	ctrl := &Controller{
-		v1alpha1Runtime: v1alpha1Runtime,
-		consoleLogLevel: zap.NewAtomicLevel(),
+		v1alpha1Runtime:    v1alpha1Runtime,
+		consoleLogLevel:    zap.NewAtomicLevel(),
+		controllerAdapters: map[string]controller.Runtime{},
+		controllerStatuses: newControllerStatuses(),
+		metrics:            newControllerMetrics(),
 	}
 
 	logWriter, err := loggingManager.ServiceLog("controller-runtime").Writer()
@@ -67,6 +79,9 @@ func (ctrl *Controller) Run(ctx context.Context) error {
 
 	for _, c := range []controller.Controller{
 		&v1alpha1.BootstrapStatusController{},
+		&v1alpha1.ControllerStatusController{
+			Source: ctrl.controllerStatuses,
+		},
 		&v1alpha1.ServiceController{
 			// V1Events
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
@@ -76,18 +91,23 @@ func (ctrl *Controller) Run(ctx context.Context) error {
 		},
 		&config.MachineTypeController{},
 		&config.K8sControlPlaneController{},
+		&etcd.MemberStatusController{},
 		&files.EtcFileController{
 			EtcPath:    "/etc",
 			ShadowPath: constants.SystemEtcPath,
 		},
+		&k8s.AddressFilterController{},
 		&k8s.ControlPlaneStaticPodController{},
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
+		&k8s.KubeletReservedController{},
 		&k8s.KubeletStaticPodController{},
 		&k8s.ManifestController{},
 		&k8s.ManifestApplyController{},
 		&k8s.NodenameController{},
+		&k8s.NodeIPController{},
 		&k8s.RenderSecretsStaticPodController{},
+		&k8s.StaticPodWatchdogController{},
 		&network.AddressConfigController{
 			Cmdline:      procfs.ProcCmdline(),
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -110,6 +130,7 @@ func (ctrl *Controller) Run(ctx context.Context) error {
 		&network.LinkStatusController{},
 		&network.LinkSpecController{},
 		&network.NodeAddressController{},
+		&network.NodeAddressFilterConfigController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -143,7 +164,7 @@ func (ctrl *Controller) Run(ctx context.Context) error {
 		&secrets.KubernetesController{},
 		&secrets.RootController{},
 	} {
-		if err := ctrl.controllerRuntime.RegisterController(c); err != nil {
+		if err := ctrl.controllerRuntime.RegisterController(&trackedController{Controller: c, parent: ctrl}); err != nil {
 			return err
 		}
 	}
@@ -156,6 +177,21 @@ func (ctrl *Controller) DependencyGraph() (*controller.DependencyGraph, error) {
 	return ctrl.controllerRuntime.GetDependencyGraph()
 }
 
+// QueueReconcile queues an immediate reconcile of the controller by name.
+func (ctrl *Controller) QueueReconcile(controllerName string) error {
+	ctrl.controllerAdaptersMu.Lock()
+	r, ok := ctrl.controllerAdapters[controllerName]
+	ctrl.controllerAdaptersMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("controller %q is not running", controllerName)
+	}
+
+	r.QueueReconcile()
+
+	return nil
+}
+
 func (ctrl *Controller) watchMachineConfig(ctx context.Context, logger *zap.Logger) {
 	watchCh := make(chan state.Event)
 
@@ -169,15 +205,28 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context, logger *zap.Logg
 		return
 	}
 
+	var metricsServer, readinessServer *httpServer
+
+	defer func() {
+		metricsServer.stop()
+		readinessServer.stop()
+	}()
+
 	for {
 		logLevel := zapcore.InfoLevel
+		metricsAddress, readinessAddress := "", ""
 
 		select {
 		case event := <-watchCh:
 			if event.Type != state.Destroyed {
-				if event.Resource.(*configresource.MachineConfig).Config().Debug() {
+				cfg := event.Resource.(*configresource.MachineConfig).Config()
+
+				if cfg.Debug() {
 					logLevel = zapcore.DebugLevel
 				}
+
+				metricsAddress = cfg.Machine().MetricsListenAddress()
+				readinessAddress = cfg.Machine().ReadinessListenAddress()
 			}
 		case <-ctx.Done():
 			return
@@ -188,5 +237,8 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context, logger *zap.Logg
 
 			ctrl.logger.Info("setting console log level", zap.Stringer("level", logLevel))
 		}
+
+		metricsServer = updateHTTPServer(metricsServer, "metrics", metricsAddress, ctrl.metrics.handler(), logger)
+		readinessServer = updateHTTPServer(readinessServer, "readiness", readinessAddress, ctrl.readinessHandler(), logger)
 	}
 }
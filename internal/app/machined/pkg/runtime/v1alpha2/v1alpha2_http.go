@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// httpServer is a plain HTTP server for the node-local endpoints (metrics, readiness).
+type httpServer struct {
+	name    string
+	address string
+	server  *http.Server
+}
+
+func startHTTPServer(name, address string, handler http.Handler, logger *zap.Logger) (*httpServer, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &httpServer{
+		name:    name,
+		address: address,
+		server: &http.Server{
+			Handler: handler,
+		},
+	}
+
+	go func() {
+		if err := srv.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Warn("http server failed", zap.String("server", name), zap.String("address", address), zap.Error(err))
+		}
+	}()
+
+	logger.Info("http server started", zap.String("server", name), zap.String("address", address))
+
+	return srv, nil
+}
+
+// updateHTTPServer restarts the server if the address changes, the server is stopped if the address is empty.
+func updateHTTPServer(srv *httpServer, name, address string, handler http.Handler, logger *zap.Logger) *httpServer {
+	if srv != nil && srv.address != address {
+		srv.stop()
+
+		srv = nil
+	}
+
+	if srv == nil && address != "" {
+		var err error
+
+		if srv, err = startHTTPServer(name, address, handler, logger); err != nil {
+			logger.Warn("error starting http server", zap.String("server", name), zap.String("address", address), zap.Error(err))
+		}
+	}
+
+	return srv
+}
+
+func (srv *httpServer) stop() {
+	if srv == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.server.Shutdown(ctx) //nolint:errcheck
+}
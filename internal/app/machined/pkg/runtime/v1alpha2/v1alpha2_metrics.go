@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// controllerMetrics collects the metrics of the controllers.
+type controllerMetrics struct {
+	registry *prometheus.Registry
+
+	reconciles        *prometheus.CounterVec
+	reconcileDuration *prometheus.HistogramVec
+	errors            *prometheus.CounterVec
+	queueDepth        *prometheus.GaugeVec
+}
+
+func newControllerMetrics() *controllerMetrics {
+	m := &controllerMetrics{
+		registry: prometheus.NewRegistry(),
+		reconciles: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talos_controller_reconciles_total",
+			Help: "Number of reconcile events delivered to the controller.",
+		}, []string{"controller"}),
+		reconcileDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "talos_controller_reconcile_duration_seconds",
+			Help:    "Time spent by the controller handling a reconcile event.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 9),
+		}, []string{"controller"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talos_controller_errors_total",
+			Help: "Number of times the controller failed or panicked.",
+		}, []string{"controller"}),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "talos_controller_reconcile_queue_depth",
+			Help: "Number of reconcile events waiting to be picked up by the controller.",
+		}, []string{"controller"}),
+	}
+
+	m.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.reconciles,
+		m.reconcileDuration,
+		m.errors,
+		m.queueDepth,
+	)
+
+	return m
+}
+
+// handler serves the metrics in the Prometheus format.
+func (m *controllerMetrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+
+	return mux
+}
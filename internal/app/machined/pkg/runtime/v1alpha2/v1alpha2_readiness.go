@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/resources/network"
+	"github.com/talos-systems/talos/pkg/resources/secrets"
+	timeresource "github.com/talos-systems/talos/pkg/resources/time"
+	v1alpha1resource "github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+// readinessCheckTimeout is the time a readiness condition is given to be satisfied.
+//
+// Conditions are evaluated against the current state, so they either pass immediately or fail.
+const readinessCheckTimeout = 100 * time.Millisecond
+
+// readinessHandler serves the node readiness endpoint.
+//
+// Node is ready when the time is in sync, the network is fully configured, the machine API certificates
+// are issued, and none of the running services fails its health check.
+// Not ready node responds with 503 listing the failed checks.
+func (ctrl *Controller) readinessHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		failed := ctrl.checkReadiness(r.Context())
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		if len(failed) > 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, strings.Join(failed, "\n")) //nolint:errcheck
+
+			return
+		}
+
+		fmt.Fprintln(w, "ok") //nolint:errcheck
+	})
+
+	return mux
+}
+
+// checkReadiness returns the list of failed readiness checks.
+func (ctrl *Controller) checkReadiness(ctx context.Context) []string {
+	resources := ctrl.v1alpha1Runtime.State().V1Alpha2().Resources()
+
+	var failed []string
+
+	for _, condition := range []conditions.Condition{
+		timeresource.NewSyncCondition(resources),
+		network.NewReadyCondition(resources, network.AddressReady, network.ConnectivityReady, network.HostnameReady, network.EtcFilesReady),
+		secrets.NewAPIReadyCondition(resources),
+	} {
+		checkCtx, checkCancel := context.WithTimeout(ctx, readinessCheckTimeout)
+		err := condition.Wait(checkCtx)
+
+		checkCancel()
+
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: not ready", condition))
+		}
+	}
+
+	services, err := resources.List(ctx, resource.NewMetadata(v1alpha1resource.NamespaceName, v1alpha1resource.ServiceType, "", resource.VersionUndefined))
+	if err != nil {
+		return append(failed, fmt.Sprintf("services: %s", err))
+	}
+
+	for _, res := range services.Items {
+		svc := res.(*v1alpha1resource.Service) //nolint:errcheck,forcetypeassert
+
+		// services without health checks report unknown health
+		if svc.Running() && !svc.Healthy() && !svc.Unknown() {
+			failed = append(failed, fmt.Sprintf("service %q: unhealthy", svc.Metadata().ID()))
+		}
+	}
+
+	return failed
+}
@@ -15,6 +15,7 @@ import (
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/etcd"
 	"github.com/talos-systems/talos/pkg/resources/files"
 	"github.com/talos-systems/talos/pkg/resources/k8s"
 	"github.com/talos-systems/talos/pkg/resources/network"
@@ -57,6 +58,7 @@ func NewState() (*State, error) {
 	}{
 		{v1alpha1.NamespaceName, "Talos v1alpha1 subsystems glue resources."},
 		{config.NamespaceName, "Talos node configuration."},
+		{etcd.NamespaceName, "etcd cluster state."},
 		{files.NamespaceName, "Files and file-like resources."},
 		{k8s.ControlPlaneNamespaceName, "Kubernetes control plane resources."},
 		{network.NamespaceName, "Networking resources."},
@@ -72,16 +74,21 @@ func NewState() (*State, error) {
 	// register Talos resources
 	for _, r := range []resource.Resource{
 		&v1alpha1.BootstrapStatus{},
+		&v1alpha1.ControllerStatus{},
 		&v1alpha1.Service{},
 		&config.MachineConfig{},
 		&config.MachineType{},
 		&config.K8sControlPlane{},
+		&etcd.Member{},
+		&etcd.QuorumStatus{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
 		&k8s.Endpoint{},
+		&k8s.KubeletReserved{},
 		&k8s.Manifest{},
 		&k8s.ManifestStatus{},
 		&k8s.Nodename{},
+		&k8s.NodeIP{},
 		&k8s.StaticPod{},
 		&k8s.StaticPodStatus{},
 		&k8s.SecretsStatus{},
@@ -93,6 +100,7 @@ func NewState() (*State, error) {
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NodeAddress{},
+		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
@@ -106,6 +114,7 @@ func NewState() (*State, error) {
 		&secrets.API{},
 		&secrets.Etcd{},
 		&secrets.Kubernetes{},
+		&secrets.KubernetesStatus{},
 		&secrets.Root{},
 		&time.Status{},
 	} {
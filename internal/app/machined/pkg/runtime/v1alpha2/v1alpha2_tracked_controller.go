@@ -0,0 +1,206 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"go.uber.org/zap"
+
+	v1alpha1resource "github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+// trackedController wraps the controller to keep track of its state.
+//
+// It captures controller.Runtime of the wrapped controller, so that reconcile can be queued on demand
+// via Controller.QueueReconcile, and it reports controller status to controllerStatuses and controllerMetrics.
+type trackedController struct {
+	controller.Controller
+
+	parent *Controller
+}
+
+// Run implements controller.Controller interface.
+func (c *trackedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) (err error) {
+	name := c.Name()
+
+	c.parent.controllerAdaptersMu.Lock()
+	c.parent.controllerAdapters[name] = r
+	c.parent.controllerAdaptersMu.Unlock()
+
+	c.parent.controllerStatuses.update(name, func(status *v1alpha1resource.ControllerStatusSpec) {
+		status.State = v1alpha1resource.ControllerStateRunning
+	})
+
+	defer func() {
+		c.parent.controllerAdaptersMu.Lock()
+		delete(c.parent.controllerAdapters, name)
+		c.parent.controllerAdaptersMu.Unlock()
+
+		if p := recover(); p != nil {
+			c.parent.metrics.errors.WithLabelValues(name).Inc()
+
+			c.parent.controllerStatuses.update(name, func(status *v1alpha1resource.ControllerStatusSpec) {
+				status.State = v1alpha1resource.ControllerStateFailed
+				status.LastError = fmt.Sprintf("controller panicked: %v", p)
+			})
+
+			panic(p)
+		}
+
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.parent.metrics.errors.WithLabelValues(name).Inc()
+		}
+
+		c.parent.controllerStatuses.update(name, func(status *v1alpha1resource.ControllerStatusSpec) {
+			if err != nil && !errors.Is(err, context.Canceled) {
+				status.State = v1alpha1resource.ControllerStateFailed
+				status.LastError = err.Error()
+			} else {
+				status.State = v1alpha1resource.ControllerStateStopped
+			}
+		})
+	}()
+
+	runCtx, runCancel := context.WithCancel(ctx)
+	defer runCancel()
+
+	return c.Controller.Run(runCtx, newTrackedRuntime(runCtx, r, name, c.parent.metrics, func() {
+		c.parent.controllerStatuses.update(name, func(status *v1alpha1resource.ControllerStatusSpec) {
+			status.LastReconcile = time.Now()
+		})
+	}), logger)
+}
+
+// trackedRuntime intercepts reconcile events delivered to the controller.
+//
+// Reconcile duration is measured from the delivery of the event till the controller asks for the event channel
+// again, so it is only reported for the controllers which call EventCh on each iteration of the reconcile loop.
+type trackedRuntime struct {
+	controller.Runtime
+
+	eventCh chan controller.ReconcileEvent
+
+	name    string
+	metrics *controllerMetrics
+
+	reconcileMu    sync.Mutex
+	reconcileStart time.Time
+}
+
+func newTrackedRuntime(ctx context.Context, r controller.Runtime, name string, metrics *controllerMetrics, onReconcile func()) *trackedRuntime {
+	tracked := &trackedRuntime{
+		Runtime: r,
+		eventCh: make(chan controller.ReconcileEvent),
+		name:    name,
+		metrics: metrics,
+	}
+
+	queueDepth := metrics.queueDepth.WithLabelValues(name)
+
+	go func() {
+		for {
+			var event controller.ReconcileEvent
+
+			select {
+			case <-ctx.Done():
+				return
+			case event = <-r.EventCh():
+			}
+
+			queueDepth.Inc()
+
+			select {
+			case <-ctx.Done():
+				queueDepth.Dec()
+
+				return
+			case tracked.eventCh <- event:
+				queueDepth.Dec()
+
+				tracked.reconcileMu.Lock()
+				tracked.reconcileStart = time.Now()
+				tracked.reconcileMu.Unlock()
+
+				metrics.reconciles.WithLabelValues(name).Inc()
+
+				onReconcile()
+			}
+		}
+	}()
+
+	return tracked
+}
+
+// EventCh implements controller.Runtime interface.
+func (r *trackedRuntime) EventCh() <-chan controller.ReconcileEvent {
+	r.reconcileMu.Lock()
+
+	if !r.reconcileStart.IsZero() {
+		r.metrics.reconcileDuration.WithLabelValues(r.name).Observe(time.Since(r.reconcileStart).Seconds())
+
+		r.reconcileStart = time.Time{}
+	}
+
+	r.reconcileMu.Unlock()
+
+	return r.eventCh
+}
+
+// controllerStatuses keeps track of the controller statuses.
+//
+// controllerStatuses implements v1alpha1.ControllerStatusSource.
+type controllerStatuses struct {
+	mu       sync.Mutex
+	statuses map[string]v1alpha1resource.ControllerStatusSpec
+
+	notifyCh chan struct{}
+}
+
+func newControllerStatuses() *controllerStatuses {
+	return &controllerStatuses{
+		statuses: map[string]v1alpha1resource.ControllerStatusSpec{},
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+func (s *controllerStatuses) update(name string, f func(*v1alpha1resource.ControllerStatusSpec)) {
+	s.mu.Lock()
+	status := s.statuses[name]
+	f(&status)
+	s.statuses[name] = status
+	s.mu.Unlock()
+
+	// notification is queued if the channel is empty,
+	// otherwise notification is already pending
+	select {
+	case s.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Statuses implements v1alpha1.ControllerStatusSource interface.
+func (s *controllerStatuses) Statuses() map[string]v1alpha1resource.ControllerStatusSpec {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]v1alpha1resource.ControllerStatusSpec, len(s.statuses))
+
+	for name, status := range s.statuses {
+		result[name] = status
+	}
+
+	return result
+}
+
+// Notify implements v1alpha1.ControllerStatusSource interface.
+func (s *controllerStatuses) Notify() <-chan struct{} {
+	return s.notifyCh
+}
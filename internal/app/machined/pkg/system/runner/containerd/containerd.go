@@ -261,6 +261,16 @@ func (c *containerdRunner) newOCISpecOpts(image oci.Image) []oci.SpecOpts {
 		oci.WithHostResolvconf,
 	)
 
+	if c.opts.Resources != nil {
+		if memoryMax := c.opts.Resources.MemoryMax(); memoryMax > 0 {
+			specOpts = append(specOpts, oci.WithMemoryLimit(memoryMax))
+		}
+
+		if cpuWeight := c.opts.Resources.CPUWeight(); cpuWeight > 0 {
+			specOpts = append(specOpts, oci.WithCPUShares(runner.CPUShares(cpuWeight)))
+		}
+	}
+
 	specOpts = append(specOpts,
 		c.opts.OCISpecOpts...,
 	)
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package process
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+
+	// cgroupParent matches the parent cgroup of the system services run by containerd.
+	cgroupParent = "system"
+
+	// wrapperdName is the name machined recognizes to run as wrapperd.
+	wrapperdName = "/wrapperd"
+)
+
+// setupCgroups creates dedicated cgroups for the process with resource limits applied.
+//
+// It returns the list of cgroup paths the process should be placed into.
+func (p *processRunner) setupCgroups() ([]string, error) {
+	if p.opts.Resources == nil {
+		return nil, nil
+	}
+
+	type limit struct {
+		controller string
+		file       string
+		value      uint64
+	}
+
+	var limits []limit
+
+	if memoryMax := p.opts.Resources.MemoryMax(); memoryMax > 0 {
+		limits = append(limits, limit{"memory", "memory.limit_in_bytes", memoryMax})
+	}
+
+	if cpuWeight := p.opts.Resources.CPUWeight(); cpuWeight > 0 {
+		limits = append(limits, limit{"cpu", "cpu.shares", runner.CPUShares(cpuWeight)})
+	}
+
+	paths := make([]string, 0, len(limits))
+
+	for _, l := range limits {
+		path := filepath.Join(cgroupRoot, l.controller, cgroupParent, p.args.ID)
+
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			return nil, fmt.Errorf("error creating cgroup %q: %w", path, err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(path, l.file), []byte(strconv.FormatUint(l.value, 10)), 0o644); err != nil {
+			return nil, fmt.Errorf("error setting %q: %w", l.file, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// wrapCgroups builds the command which runs the process via wrapperd.
+//
+// Wrapperd places itself into the cgroups and then executes the process, so that the process
+// and all its children start in the cgroups (adding the process to the cgroups after it was started
+// leaves out the children forked before that).
+// Wrapperd is built into machined, so machined executes itself with wrapperd name.
+func wrapCgroups(paths, args []string) *exec.Cmd {
+	cmd := exec.Command("/proc/self/exe", append([]string{"-cgroup-paths", strings.Join(paths, ","), "--"}, args...)...)
+	cmd.Args[0] = wrapperdName
+
+	return cmd
+}
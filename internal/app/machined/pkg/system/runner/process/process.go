@@ -76,8 +76,12 @@ func (p *processRunner) Close() error {
 	return nil
 }
 
-func (p *processRunner) build() (cmd *exec.Cmd, logCloser io.Closer, err error) {
-	cmd = exec.Command(p.args.ProcessArgs[0], p.args.ProcessArgs[1:]...)
+func (p *processRunner) build(cgroupPaths []string) (cmd *exec.Cmd, logCloser io.Closer, err error) {
+	if len(cgroupPaths) > 0 {
+		cmd = wrapCgroups(cgroupPaths, p.args.ProcessArgs)
+	} else {
+		cmd = exec.Command(p.args.ProcessArgs[0], p.args.ProcessArgs[1:]...)
+	}
 
 	// Set the environment for the service.
 	cmd.Env = append([]string{fmt.Sprintf("PATH=%s", constants.PATH)}, p.opts.Env...)
@@ -104,7 +108,12 @@ func (p *processRunner) build() (cmd *exec.Cmd, logCloser io.Closer, err error)
 }
 
 func (p *processRunner) run(eventSink events.Recorder) error {
-	cmd, logCloser, err := p.build()
+	cgroupPaths, err := p.setupCgroups()
+	if err != nil {
+		return fmt.Errorf("error setting up cgroups: %w", err)
+	}
+
+	cmd, logCloser, err := p.build(cgroupPaths)
 	if err != nil {
 		return fmt.Errorf("error building command: %w", err)
 	}
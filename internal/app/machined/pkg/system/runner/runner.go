@@ -16,6 +16,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -56,6 +57,8 @@ type Options struct {
 	GracefulShutdownTimeout time.Duration
 	// Stdin is the process standard input.
 	Stdin io.ReadSeeker
+	// Resources describes the cgroup resource limits of the service.
+	Resources config.ServiceResources
 }
 
 // Option is the functional option func.
@@ -135,3 +138,20 @@ func WithStdin(stdin io.ReadSeeker) Option {
 		args.Stdin = stdin
 	}
 }
+
+// WithResources sets the cgroup resource limits of the service.
+func WithResources(resources config.ServiceResources) Option {
+	return func(args *Options) {
+		args.Resources = resources
+	}
+}
+
+// CPUShares converts cgroup v2 CPU weight (1-10000, default 100) to the cgroup v1 CPU shares (default 1024).
+func CPUShares(weight uint64) uint64 {
+	shares := weight * 1024 / 100
+	if shares < 2 {
+		shares = 2
+	}
+
+	return shares
+}
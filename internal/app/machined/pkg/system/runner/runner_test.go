@@ -4,11 +4,26 @@
 
 package runner_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+)
+
+func TestCPUShares(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		weight uint64
+		shares uint64
+	}{
+		{weight: 1, shares: 10},
+		{weight: 50, shares: 512},
+		{weight: 100, shares: 1024},
+		{weight: 10000, shares: 102400},
+	} {
+		assert.Equal(t, tt.shares, runner.CPUShares(tt.weight))
+	}
 }
@@ -56,3 +56,34 @@ func (sc *serviceCondition) String() string {
 func WaitForService(event StateEvent, service string) conditions.Condition {
 	return &serviceCondition{event, service}
 }
+
+// unmetDependencies returns the list of services which are not up.
+func unmetDependencies(services []string) []string {
+	var unmet []string
+
+	for _, service := range services {
+		var svcrunner *ServiceRunner
+
+		if instance != nil {
+			instance.mu.Lock()
+			svcrunner = instance.state[service]
+			instance.mu.Unlock()
+		}
+
+		if svcrunner == nil {
+			unmet = append(unmet, service)
+
+			continue
+		}
+
+		svcrunner.mu.Lock()
+		up := svcrunner.inStateLocked(StateEventUp)
+		svcrunner.mu.Unlock()
+
+		if !up {
+			unmet = append(unmet, service)
+		}
+	}
+
+	return unmet
+}
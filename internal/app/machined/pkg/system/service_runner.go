@@ -349,14 +349,25 @@ func (svcrunner *ServiceRunner) Shutdown() {
 // AsProto returns protobuf struct with the state of the service runner.
 func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
 	svcrunner.mu.Lock()
-	defer svcrunner.mu.Unlock()
 
-	return &machineapi.ServiceInfo{
-		Id:     svcrunner.id,
-		State:  svcrunner.state.String(),
-		Events: svcrunner.events.AsProto(events.MaxEventsToKeep),
-		Health: svcrunner.healthState.AsProto(),
+	info := &machineapi.ServiceInfo{
+		Id:        svcrunner.id,
+		State:     svcrunner.state.String(),
+		Events:    svcrunner.events.AsProto(events.MaxEventsToKeep),
+		Health:    svcrunner.healthState.AsProto(),
+		DependsOn: svcrunner.service.DependsOn(svcrunner.runtime),
 	}
+
+	waiting := svcrunner.state == events.StateWaiting
+
+	svcrunner.mu.Unlock()
+
+	// dependencies are checked with the lock released, as it requires locking other service runners
+	if waiting {
+		info.WaitingFor = unmetDependencies(info.DependsOn)
+	}
+
+	return info
 }
 
 // Subscribe to a specific event for this service.
@@ -115,6 +115,10 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args.ProcessArgs = append(args.ProcessArgs, "--enable-rbac")
 	}
 
+	if subnets := r.Config().Machine().APIListenSubnets(); len(subnets) > 0 {
+		args.ProcessArgs = append(args.ProcessArgs, "--listen-subnets="+strings.Join(subnets, ","))
+	}
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/etc/ssl", Source: "/etc/ssl", Options: []string{"bind", "ro"}},
@@ -76,6 +76,7 @@ func (c *CRI) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug(),
 		args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithResources(r.Config().Machine().ServiceResources()[c.ID(r)]),
 		runner.WithEnv(env),
 	),
 		restart.WithType(restart.Forever),
@@ -6,14 +6,18 @@ package services
 
 import (
 	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	stdnet "net"
 	"os"
 	goruntime "runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	containerdapi "github.com/containerd/containerd"
@@ -160,6 +164,7 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug(),
 		&args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithResources(r.Config().Machine().ServiceResources()[e.ID(r)]),
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(r.Config().Machine().Kubelet().Image()),
 		runner.WithContainerImage(r.Config().Cluster().Etcd().Image()),
@@ -308,6 +313,72 @@ func addMember(ctx context.Context, r runtime.Runtime, addrs []string, name stri
 	return list, add.Member.ID, nil
 }
 
+// etcdClusterExists checks whether the etcd cluster is already running on the control plane nodes.
+//
+// Kubernetes API server runs only on the members of the etcd cluster, so the cluster exists if the API server
+// answers on the control plane endpoint, and it doesn't exist if nothing serves the endpoint.
+// Any other outcome is not conclusive, so the check is retried until it times out.
+func etcdClusterExists(ctx context.Context, r runtime.Runtime) (exists bool, err error) {
+	endpoint := r.Config().Cluster().Endpoint()
+
+	port := endpoint.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	rootCAs := stdx509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(r.Config().Cluster().CA().Crt) {
+		return false, fmt.Errorf("failed to parse Kubernetes CA certificate")
+	}
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: endpoint.Hostname(),
+		},
+	}
+
+	err = retry.Constant(10*time.Minute,
+		retry.WithUnits(3*time.Second),
+		retry.WithJitter(time.Second),
+		retry.WithErrorLogging(true),
+	).RetryWithContext(ctx, func(ctx context.Context) error {
+		dialCtx, dialCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer dialCtxCancel()
+
+		exists, err = probeEndpoint(dialCtx, dialer, stdnet.JoinHostPort(endpoint.Hostname(), port))
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether etcd cluster exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// probeEndpoint checks whether the API server is serving the endpoint.
+//
+// An error is returned if the result is not conclusive.
+func probeEndpoint(ctx context.Context, dialer *tls.Dialer, address string) (bool, error) {
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err == nil {
+		return true, conn.Close()
+	}
+
+	// nothing listens on the endpoint, nobody holds the address (e.g. shared IP is not claimed)
+	// or the load balancer has no upstreams to forward the connection to
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EHOSTUNREACH) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) {
+		return false, nil
+	}
+
+	return false, fmt.Errorf("error probing %q: %w", address, err)
+}
+
 func buildInitialCluster(ctx context.Context, r runtime.Runtime, name, ip string) (initial string, err error) {
 	err = retry.Constant(10*time.Minute,
 		retry.WithUnits(3*time.Second),
@@ -414,6 +485,13 @@ func (e *Etcd) argsForInit(ctx context.Context, r runtime.Runtime) error {
 		}
 	}
 
+	// recovered data directory is picked up below as an existing cluster
+	if e.RecoverFromSnapshot {
+		if err = e.recoverFromSnapshot(hostname, primaryAddr); err != nil {
+			return err
+		}
+	}
+
 	if !extraArgs.Contains("initial-cluster-state") {
 		denyListArgs.Set("initial-cluster-state", "new")
 	}
@@ -429,7 +507,17 @@ func (e *Etcd) argsForInit(ctx context.Context, r runtime.Runtime) error {
 		if ok {
 			initialCluster := fmt.Sprintf("%s=https://%s:2380", hostname, net.FormatAddress(primaryAddr))
 
-			if upgraded {
+			// after an upgrade or a reset the data directory is empty, but the cluster bootstrapped by the init node
+			// keeps running on other control plane nodes, so the init node should join it instead of starting a new one
+			exists := upgraded
+
+			if !exists {
+				if exists, err = etcdClusterExists(ctx, r); err != nil {
+					return err
+				}
+			}
+
+			if exists {
 				denyListArgs.Set("initial-cluster-state", "existing")
 
 				initialCluster, err = buildInitialCluster(ctx, r, hostname, primaryAddr)
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services //nolint:testpackage // to test unexported function(s)
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	stdnet "net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeEndpoint(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	rootCAs := stdx509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: "example.com",
+		},
+	}
+
+	// API server is running
+	exists, err := probeEndpoint(ctx, dialer, server.Listener.Addr().String())
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// load balancer without upstreams closes the connection
+	lis, err := stdnet.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, e := lis.Accept()
+			if e != nil {
+				return
+			}
+
+			conn.Close() //nolint:errcheck
+		}
+	}()
+
+	exists, err = probeEndpoint(ctx, dialer, lis.Addr().String())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// nothing listens on the endpoint
+	require.NoError(t, lis.Close())
+
+	exists, err = probeEndpoint(ctx, dialer, lis.Addr().String())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// certificate can't be verified, so the result is not conclusive
+	_, err = probeEndpoint(ctx, &tls.Dialer{Config: &tls.Config{ServerName: "example.com"}}, server.Listener.Addr().String())
+	assert.Error(t, err)
+}
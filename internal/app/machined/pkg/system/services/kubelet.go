@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"text/template"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
 	cni "github.com/containerd/go-cni"
+	"github.com/cosi-project/runtime/pkg/resource"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
@@ -134,6 +136,8 @@ func (k *Kubelet) Condition(r runtime.Runtime) conditions.Condition {
 		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
 		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady),
 		k8s.NewNodenameReadyCondition(r.State().V1Alpha2().Resources()),
+		k8s.NewNodeIPReadyCondition(r.State().V1Alpha2().Resources()),
+		k8s.NewKubeletReservedReadyCondition(r.State().V1Alpha2().Resources()),
 	)
 }
 
@@ -191,6 +195,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug() && r.Config().Machine().Type() == machine.TypeWorker, // enable debug logs only for the worker nodes
 		&args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithResources(r.Config().Machine().ServiceResources()[k.ID(r)]),
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(r.Config().Machine().Kubelet().Image()),
 		runner.WithEnv(env),
@@ -241,7 +246,7 @@ func (k *Kubelet) HealthSettings(runtime.Runtime) *health.Settings {
 	return &settings
 }
 
-func newKubeletConfiguration(clusterDNS []string, dnsDomain string) *kubeletconfig.KubeletConfiguration {
+func newKubeletConfiguration(clusterDNS []string, dnsDomain string, reserved *k8s.KubeletReservedSpec) *kubeletconfig.KubeletConfiguration {
 	f := false
 	t := true
 
@@ -272,6 +277,10 @@ func newKubeletConfiguration(clusterDNS []string, dnsDomain string) *kubeletconf
 		ClusterDNS:          clusterDNS,
 		SerializeImagePulls: &f,
 		FailSwapOn:          &f,
+		// reserved resources are subtracted from the node allocatable enforced on the pods cgroup,
+		// so that pods can't consume resources required by the system and Kubernetes daemons
+		SystemReserved: reserved.SystemReserved,
+		KubeReserved:   reserved.KubeReserved,
 	}
 }
 
@@ -309,9 +318,37 @@ func (k *Kubelet) args(r runtime.Runtime) ([]string, error) {
 		}
 	}
 
+	// node IP picked by the controller can still be overridden via extraArgs
+	if !extraArgs.Contains("node-ip") {
+		var ips []string
+
+		ips, err = nodeIPs(r)
+		if err != nil {
+			return nil, err
+		}
+
+		denyListArgs["node-ip"] = strings.Join(ips, ",")
+	}
+
 	return denyListArgs.Merge(extraArgs).Args(), nil
 }
 
+func nodeIPs(r runtime.Runtime) ([]string, error) {
+	nodeIP, err := r.State().V1Alpha2().Resources().Get(context.Background(), resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.NodeIPType, k8s.KubeletID, resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error getting node IP resource: %w", err)
+	}
+
+	addresses := nodeIP.(*k8s.NodeIP).TypedSpec().Addresses
+	result := make([]string, len(addresses))
+
+	for i := range addresses {
+		result[i] = addresses[i].String()
+	}
+
+	return result, nil
+}
+
 func writeKubeletConfig(r runtime.Runtime) error {
 	dnsServiceIPs, err := r.Config().Cluster().Network().DNSServiceIPs()
 	if err != nil {
@@ -329,7 +366,12 @@ func writeKubeletConfig(r runtime.Runtime) error {
 		dnsServiceIPsString = dnsServiceIPsCustom
 	}
 
-	kubeletConfiguration := newKubeletConfiguration(dnsServiceIPsString, r.Config().Cluster().Network().DNSDomain())
+	reserved, err := r.State().V1Alpha2().Resources().Get(context.Background(), resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.KubeletReservedType, k8s.KubeletID, resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error getting kubelet reserved resources: %w", err)
+	}
+
+	kubeletConfiguration := newKubeletConfiguration(dnsServiceIPsString, r.Config().Cluster().Network().DNSDomain(), reserved.(*k8s.KubeletReserved).TypedSpec())
 
 	serializer := json.NewSerializerWithOptions(
 		json.DefaultMetaFactory,
@@ -8,6 +8,9 @@ import (
 	"context"
 	"io"
 	"log"
+	"time"
+
+	"golang.org/x/time/rate"
 
 	v1alpha1server "github.com/talos-systems/talos/internal/app/machined/internal/server/v1alpha1"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -16,7 +19,9 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/ratelimit"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/role"
 )
@@ -27,13 +32,20 @@ var rules = map[string]role.Set{
 	"/inspect.InspectService/ControllerRuntimeDependencies": role.MakeSet(role.Admin, role.Reader),
 
 	"/machine.MachineService/ApplyConfiguration":           role.MakeSet(role.Admin),
+	"/machine.MachineService/ArchiveOut":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Bootstrap":                    role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                      role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Config":                       role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/ConfigDiff":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Containers":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Copy":                         role.MakeSet(role.Admin),
+	"/machine.MachineService/Cordon":                       role.MakeSet(role.Admin),
 	"/machine.MachineService/DiskStats":                    role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/DiskUsage":                    role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Dmesg":                        role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Drain":                        role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdCompact":                  role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdDefragment":               role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdForfeitLeadership":        role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdLeaveCluster":             role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdMemberList":               role.MakeSet(role.Admin, role.Reader),
@@ -51,9 +63,12 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Memory":                       role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Mounts":                       role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/NetworkDeviceStats":           role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Pressure":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Processes":                    role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Read":                         role.MakeSet(role.Admin),
+	"/machine.MachineService/ReadFile":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Reboot":                       role.MakeSet(role.Admin),
+	"/machine.MachineService/ReconcileNow":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/RemoveBootkubeInitializedKey": role.MakeSet(role.Admin),
 	"/machine.MachineService/Reset":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Restart":                      role.MakeSet(role.Admin),
@@ -64,7 +79,9 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/ServiceStop":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/Shutdown":                     role.MakeSet(role.Admin),
 	"/machine.MachineService/Stats":                        role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/SupportBundle":                role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/SystemStat":                   role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Uncordon":                     role.MakeSet(role.Admin),
 	"/machine.MachineService/Upgrade":                      role.MakeSet(role.Admin),
 	"/machine.MachineService/Version":                      role.MakeSet(role.Admin, role.Reader),
 
@@ -82,6 +99,58 @@ var rules = map[string]role.Set{
 	"/time.TimeService/TimeCheck": role.MakeSet(role.Admin, role.Reader),
 }
 
+// defaultRateLimits limit the expensive calls unless overridden with .machine.apiRateLimits.
+var defaultRateLimits = map[string]ratelimit.Limit{
+	"/machine.MachineService/Processes":     {Rate: 1, Burst: 5},
+	"/machine.MachineService/Stats":         {Rate: 1, Burst: 5},
+	"/machine.MachineService/SupportBundle": {Rate: rate.Every(time.Minute), Burst: 1},
+}
+
+// rateLimit returns the rate limit for the method from the machine configuration or the default one.
+func rateLimit(r runtime.Runtime) func(fullMethod string) (ratelimit.Limit, bool) {
+	return func(fullMethod string) (ratelimit.Limit, bool) {
+		if r.Config() != nil && r.Config().Machine() != nil {
+			if limit, ok := r.Config().Machine().APIRateLimits()[fullMethod]; ok {
+				return ratelimit.Limit{
+					Rate:  rate.Limit(limit.Rate()),
+					Burst: limit.Burst(),
+				}, true
+			}
+		}
+
+		limit, ok := defaultRateLimits[fullMethod]
+
+		return limit, ok
+	}
+}
+
+// auditFilter selects the calls recorded to the audit log: the ones not available to the reader role.
+func auditFilter(fullMethod string) bool {
+	roles, ok := rules[fullMethod]
+
+	return !ok || !roles.Includes(role.Reader)
+}
+
+// auditLog writes the audit records to the "audit" log.
+//
+// Writer is opened for each record, so that the log settings loaded from the machine configuration
+// (format, remote destinations) apply once they are known.
+type auditLog struct {
+	r runtime.Runtime
+}
+
+func (l auditLog) Write(p []byte) (int, error) {
+	w, err := l.r.Logging().ServiceLog("audit").Writer()
+	if err != nil {
+		return 0, err
+	}
+
+	//nolint:errcheck
+	defer w.Close()
+
+	return w.Write(p)
+}
+
 type machinedService struct {
 	c runtime.Controller
 }
@@ -93,12 +162,21 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		Logger: log.New(logWriter, "machined/authz/injector ", log.Flags()).Printf,
 	}
 
+	auditor := &audit.Middleware{
+		Filter: auditFilter,
+		Writer: auditLog{r},
+	}
+
 	authorizer := &authz.Authorizer{
 		Rules:         rules,
 		FallbackRoles: role.MakeSet(role.Admin),
 		Logger:        log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
 	}
 
+	limiter := &ratelimit.Limiter{
+		Limit: rateLimit(r),
+	}
+
 	// Start the API server.
 	server := factory.NewServer(
 		&v1alpha1server.Server{
@@ -109,8 +187,14 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 		factory.WithStreamInterceptor(injector.StreamInterceptor()),
 
+		factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+		factory.WithStreamInterceptor(auditor.StreamInterceptor()),
+
 		factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
 		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
+
+		factory.WithUnaryInterceptor(limiter.UnaryInterceptor()),
+		factory.WithStreamInterceptor(limiter.StreamInterceptor()),
 	)
 
 	listener, err := factory.NewListener(factory.Network("unix"), factory.SocketPath(constants.MachineSocketPath))
@@ -118,8 +202,6 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		return err
 	}
 
-	defer server.Stop()
-
 	go func() {
 		//nolint:errcheck
 		server.Serve(listener)
@@ -127,6 +209,11 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 
 	<-ctx.Done()
 
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), constants.GRPCGracefulShutdownTimeout)
+	defer shutdownCancel()
+
+	factory.ServerGracefulStop(shutdownCtx, server)
+
 	return nil
 }
 
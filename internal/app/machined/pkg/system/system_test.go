@@ -10,8 +10,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
 
 type SystemServicesSuite struct {
@@ -47,3 +50,51 @@ func (suite *SystemServicesSuite) TestStartStop() {
 	)
 	suite.Assert().NoError(err)
 }
+
+func (suite *SystemServicesSuite) TestDependencies() {
+	dependency := &MockHealthcheckedService{MockService: MockService{name: "networkd"}}
+	dependency.SetHealthy(false)
+
+	system.Services(nil).LoadAndStart(
+		dependency,
+		&MockService{name: "cri", dependencies: []string{"networkd"}},
+	)
+
+	defer func() {
+		suite.Assert().NoError(system.Services(nil).Unload(context.Background(), "cri", "networkd"))
+	}()
+
+	serviceInfo := func(id string) *machineapi.ServiceInfo {
+		for _, svcrunner := range system.Services(nil).List() {
+			if info := svcrunner.AsProto(); info.Id == id {
+				return info
+			}
+		}
+
+		return nil
+	}
+
+	waitForState := func(id string, state events.ServiceState) {
+		suite.Require().NoError(retry.Constant(time.Second, retry.WithUnits(10*time.Millisecond)).Retry(func() error {
+			if info := serviceInfo(id); info.State != state.String() {
+				return retry.ExpectedErrorf("service %q is in state %q", id, info.State)
+			}
+
+			return nil
+		}))
+	}
+
+	waitForState("cri", events.StateWaiting)
+
+	info := serviceInfo("cri")
+	suite.Assert().Equal([]string{"networkd"}, info.DependsOn)
+	suite.Assert().Equal([]string{"networkd"}, info.WaitingFor)
+
+	dependency.SetHealthy(true)
+
+	waitForState("cri", events.StateRunning)
+
+	info = serviceInfo("cri")
+	suite.Assert().Equal([]string{"networkd"}, info.DependsOn)
+	suite.Assert().Empty(info.WaitingFor)
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package wrapperd implements a wrapper which places itself into the cgroups and executes the service process.
+//
+// The service process is executed only after it is placed into the cgroups, so that any process it forks
+// is subject to the cgroup limits as well.
+package wrapperd
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Main is the entrypoint into wrapperd.
+func Main() {
+	log.SetFlags(log.Lshortfile | log.Ldate | log.Lmicroseconds | log.Ltime)
+
+	cgroupPaths := flag.String("cgroup-paths", "", "comma-separated list of cgroup paths to place the process into")
+
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		log.Fatal("no command to execute")
+	}
+
+	if *cgroupPaths != "" {
+		pid := []byte(strconv.Itoa(os.Getpid()))
+
+		for _, path := range strings.Split(*cgroupPaths, ",") {
+			if err := ioutil.WriteFile(filepath.Join(path, "cgroup.procs"), pid, 0o644); err != nil {
+				log.Fatalf("error adding process to cgroup %q: %s", path, err)
+			}
+		}
+	}
+
+	binary, err := exec.LookPath(args[0])
+	if err != nil {
+		log.Fatalf("error looking up %q: %s", args[0], err)
+	}
+
+	if err = syscall.Exec(binary, args, os.Environ()); err != nil {
+		log.Fatalf("error executing %q: %s", binary, err)
+	}
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// +build integration_api
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+
+	"github.com/talos-systems/talos/internal/integration/base"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// certRotationTestSAN is the extra API server certificate SAN used to trigger certificate regeneration.
+const certRotationTestSAN = "cert-rotation.talos.dev"
+
+// CertRotationSuite verifies Kubernetes certificate rotation.
+type CertRotationSuite struct {
+	base.K8sSuite
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+// SuiteName ...
+func (suite *CertRotationSuite) SuiteName() string {
+	return "api.CertRotationSuite"
+}
+
+// SetupTest ...
+func (suite *CertRotationSuite) SetupTest() {
+	if testing.Short() {
+		suite.T().Skip("skipping in short mode")
+	}
+
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 10*time.Minute)
+}
+
+// TearDownTest ...
+func (suite *CertRotationSuite) TearDownTest() {
+	if suite.ctxCancel != nil {
+		suite.ctxCancel()
+	}
+}
+
+// TestAPIServerCertificate verifies that API server certificate is regenerated on the control plane node.
+//
+// Certificate regeneration is triggered by an immediate config change to the API server certificate SANs,
+// the test verifies that a new certificate is issued (new serial, later expiration) and the cluster stays healthy.
+func (suite *CertRotationSuite) TestAPIServerCertificate() {
+	suite.WaitForBootDone(suite.ctx)
+
+	node := suite.RandomDiscoveredNode(machine.TypeControlPlane)
+	suite.ClearConnectionRefused(suite.ctx, node)
+
+	nodeCtx := client.WithNodes(suite.ctx, node)
+
+	before, err := suite.readAPIServerCertificate(nodeCtx)
+	suite.Require().NoError(err)
+
+	cfgData, err := suite.readFile(nodeCtx, constants.ConfigPath)
+	suite.Require().NoError(err)
+
+	// make sure the new certificate has a different NotAfter
+	time.Sleep(time.Second)
+
+	suite.applyCertSANs(nodeCtx, node, cfgData, true)
+
+	defer suite.applyCertSANs(nodeCtx, node, cfgData, false)
+
+	var after *x509.Certificate
+
+	suite.Require().NoError(retry.Constant(2*time.Minute, retry.WithUnits(5*time.Second)).Retry(func() error {
+		after, err = suite.readAPIServerCertificate(nodeCtx)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		if after.SerialNumber.Cmp(before.SerialNumber) == 0 {
+			return retry.ExpectedError(fmt.Errorf("certificate hasn't been regenerated yet"))
+		}
+
+		return nil
+	}))
+
+	suite.Assert().Contains(after.DNSNames, certRotationTestSAN)
+	suite.Assert().True(after.NotAfter.After(before.NotAfter), "certificate expiration hasn't advanced: %s -> %s", before.NotAfter, after.NotAfter)
+
+	suite.Require().NoError(retry.Constant(2*time.Minute, retry.WithUnits(5*time.Second)).Retry(func() error {
+		_, err = suite.Clientset.Discovery().ServerVersion()
+
+		return retry.ExpectedError(err)
+	}))
+
+	suite.AssertClusterHealthy(suite.ctx)
+}
+
+func (suite *CertRotationSuite) applyCertSANs(nodeCtx context.Context, node string, cfgData []byte, addTestSAN bool) {
+	provider, err := configloader.NewFromBytes(cfgData)
+	suite.Require().NoError(err, "failed to parse config from node %q", node)
+
+	cfg, ok := provider.(*v1alpha1.Config)
+	suite.Require().True(ok)
+
+	if addTestSAN {
+		if cfg.ClusterConfig.APIServerConfig == nil {
+			cfg.ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{}
+		}
+
+		cfg.ClusterConfig.APIServerConfig.CertSANs = append(cfg.ClusterConfig.APIServerConfig.CertSANs, certRotationTestSAN)
+	}
+
+	cfgDataOut, err := cfg.Bytes()
+	suite.Require().NoError(err, "failed to marshal updated machine config data (node %q)", node)
+
+	_, err = suite.Client.ApplyConfiguration(nodeCtx, &machineapi.ApplyConfigurationRequest{
+		Immediate: true,
+		Data:      cfgDataOut,
+	})
+	suite.Require().NoError(err, "failed to apply configuration (node %q)", node)
+}
+
+func (suite *CertRotationSuite) readAPIServerCertificate(nodeCtx context.Context) (*x509.Certificate, error) {
+	certPEM, err := suite.readFile(nodeCtx, filepath.Join(constants.KubernetesAPIServerSecretsDir, "apiserver.crt"))
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode API server certificate PEM")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func (suite *CertRotationSuite) readFile(nodeCtx context.Context, path string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	reader, errCh, err := suite.Client.Read(nodeCtx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error creating reader: %w", err)
+	}
+	defer reader.Close() //nolint:errcheck
+
+	if err = copyFromReaderWithErrChan(&buf, reader, errCh); err != nil {
+		return nil, fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func init() {
+	allSuites = append(allSuites, new(CertRotationSuite))
+}
@@ -66,14 +66,20 @@ func (suite *EtcdRecoverSuite) TestSnapshotRecover() {
 		suite.T().Skip("without full cluster state reset test is not reliable (can't wait for cluster readiness in between resets)")
 	}
 
-	// 'init' nodes are not compatible with etcd recovery
-	suite.Require().Empty(suite.DiscoverNodes().NodesByType(machine.TypeInit))
-
-	controlPlaneNodes := suite.DiscoverNodes().NodesByType(machine.TypeControlPlane)
+	initNodes := suite.DiscoverNodes().NodesByType(machine.TypeInit)
+	controlPlaneNodes := append(initNodes, suite.DiscoverNodes().NodesByType(machine.TypeControlPlane)...)
 	suite.Require().NotEmpty(controlPlaneNodes)
 
-	snapshotNode := suite.RandomDiscoveredNode(machine.TypeControlPlane)
-	recoverNode := suite.RandomDiscoveredNode(machine.TypeControlPlane)
+	snapshotNode := suite.RandomDiscoveredNode(machine.TypeInit, machine.TypeControlPlane)
+
+	var recoverNode string
+
+	if len(initNodes) > 0 {
+		// 'init' node bootstraps etcd on its own after the wipe, so the snapshot should be recovered there
+		recoverNode = initNodes[0]
+	} else {
+		recoverNode = suite.RandomDiscoveredNode(machine.TypeControlPlane)
+	}
 
 	suite.WaitForBootDone(suite.ctx)
 
@@ -12,6 +12,8 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"regexp"
+	"testing"
 	"time"
 
 	"github.com/talos-systems/talos/internal/integration/base"
@@ -248,6 +250,65 @@ DrainLoop:
 	}
 }
 
+// TestKubeletStreaming verifies that live kubelet logs are streamed.
+//
+// Kubelet is restarted to produce a known marker in the logs.
+func (suite *LogsSuite) TestKubeletStreaming() {
+	if testing.Short() {
+		suite.T().Skip("skipping in short mode")
+	}
+
+	logsStream, err := suite.Client.Logs(
+		suite.nodeCtx,
+		constants.SystemContainerdNamespace,
+		common.ContainerDriver_CONTAINERD,
+		"kubelet",
+		true,
+		0,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(logsStream.CloseSend())
+
+	logReader, errCh, err := client.ReadStream(logsStream)
+	suite.Require().NoError(err)
+
+	defer func() {
+		suite.ctxCancel()
+
+		logReader.Close() //nolint:errcheck
+
+		<-errCh
+	}()
+
+	markerFound := make(chan struct{})
+
+	go func() {
+		scanner := bufio.NewScanner(logReader)
+
+		for scanner.Scan() {
+			if kubeletMarker.Match(scanner.Bytes()) {
+				close(markerFound)
+
+				return
+			}
+		}
+	}()
+
+	_, err = suite.Client.ServiceRestart(suite.nodeCtx, "kubelet")
+	suite.Require().NoError(err)
+
+	select {
+	case <-markerFound:
+	case <-time.After(time.Minute):
+		suite.Assert().Fail("kubelet startup message not found in the logs stream")
+	}
+
+	suite.AssertClusterHealthy(suite.ctx)
+}
+
+var kubeletMarker = regexp.MustCompile(`Kubelet version`)
+
 func init() {
 	allSuites = append(allSuites, new(LogsSuite))
 }
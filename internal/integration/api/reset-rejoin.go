@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// +build integration_api
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/internal/integration/base"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// ResetRejoinSuite verifies that worker nodes rejoin the cluster after Reset.
+type ResetRejoinSuite struct {
+	base.K8sSuite
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+// SuiteName ...
+func (suite *ResetRejoinSuite) SuiteName() string {
+	return "api.ResetRejoinSuite"
+}
+
+// SetupTest ...
+func (suite *ResetRejoinSuite) SetupTest() {
+	if testing.Short() {
+		suite.T().Skip("skipping in short mode")
+	}
+
+	// make sure we abort at some point in time, but give enough room for Resets
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 30*time.Minute)
+}
+
+// TearDownTest ...
+func (suite *ResetRejoinSuite) TearDownTest() {
+	if suite.ctxCancel != nil {
+		suite.ctxCancel()
+	}
+}
+
+// TestResetGracefulWorker resets a worker in graceful mode and verifies that it rejoins the cluster.
+func (suite *ResetRejoinSuite) TestResetGracefulWorker() {
+	suite.testResetRejoin(true)
+}
+
+// TestResetNoGracefulWorker resets a worker in !graceful mode and verifies that it rejoins the cluster.
+func (suite *ResetRejoinSuite) TestResetNoGracefulWorker() {
+	suite.testResetRejoin(false)
+}
+
+func (suite *ResetRejoinSuite) testResetRejoin(graceful bool) {
+	if !suite.Capabilities().SupportsReboot {
+		suite.T().Skip("cluster doesn't support reboot (and reset)")
+	}
+
+	if suite.Cluster == nil {
+		suite.T().Skip("without full cluster state reset test is not reliable (can't wait for cluster readiness in between resets)")
+	}
+
+	nodes := suite.DiscoverNodes().NodesByType(machine.TypeWorker)
+	if len(nodes) == 0 {
+		suite.T().Skip("cluster doesn't have worker nodes")
+	}
+
+	suite.WaitForBootDone(suite.ctx)
+
+	node := suite.RandomDiscoveredNode(machine.TypeWorker)
+
+	k8sNode, err := suite.getK8sNodeByIP(node)
+	suite.Require().NoError(err)
+
+	bootIDBefore := k8sNode.Status.NodeInfo.BootID
+
+	suite.T().Logf("Resetting worker node %s (graceful %v)", node, graceful)
+
+	preReset, err := suite.HashKubeletCert(suite.ctx, node)
+	suite.Require().NoError(err)
+
+	suite.AssertRebooted(suite.ctx, node, func(nodeCtx context.Context) error {
+		// force reboot after reset, as this is the only mode we can test
+		return base.IgnoreGRPCUnavailable(suite.Client.Reset(nodeCtx, graceful, true))
+	}, 10*time.Minute)
+
+	suite.ClearConnectionRefused(suite.ctx, node)
+
+	postReset, err := suite.HashKubeletCert(suite.ctx, node)
+	suite.Require().NoError(err)
+
+	suite.Assert().NotEqual(preReset, postReset, "reset should lead to new kubelet cert being generated")
+
+	// node should re-register with the new boot ID and become Ready
+	suite.Require().NoError(retry.Constant(5*time.Minute, retry.WithUnits(5*time.Second)).Retry(func() error {
+		k8sNode, err = suite.getK8sNodeByIP(node)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		if k8sNode.Status.NodeInfo.BootID == bootIDBefore {
+			return retry.ExpectedError(fmt.Errorf("node %q hasn't rejoined yet", node))
+		}
+
+		if k8sNode.Spec.Unschedulable {
+			return retry.ExpectedError(fmt.Errorf("node %q is still cordoned", node))
+		}
+
+		for _, cond := range k8sNode.Status.Conditions {
+			if cond.Type == v1.NodeReady {
+				if cond.Status == v1.ConditionTrue {
+					return nil
+				}
+
+				break
+			}
+		}
+
+		return retry.ExpectedError(fmt.Errorf("node %q is not ready", node))
+	}))
+}
+
+func (suite *ResetRejoinSuite) getK8sNodeByIP(ip string) (*v1.Node, error) {
+	nodes, err := suite.Clientset.CoreV1().Nodes().List(suite.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range nodes.Items {
+		for _, nodeAddress := range nodes.Items[i].Status.Addresses {
+			if nodeAddress.Type == v1.NodeInternalIP && nodeAddress.Address == ip {
+				return &nodes.Items[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("node with internal IP %q not found", ip)
+}
+
+func init() {
+	allSuites = append(allSuites, new(ResetRejoinSuite))
+}
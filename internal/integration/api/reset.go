@@ -58,30 +58,12 @@ func (suite *ResetSuite) TestResetNodeByNode() {
 		suite.T().Skip("without full cluster state reset test is not reliable (can't wait for cluster readiness in between resets)")
 	}
 
-	initNodeAddress := ""
-
-	for _, node := range suite.Cluster.Info().Nodes {
-		if node.Type == machine.TypeInit {
-			initNodeAddress = node.IPs[0].String()
-
-			break
-		}
-	}
-
 	nodes := suite.DiscoverNodes().Nodes()
 	suite.Require().NotEmpty(nodes)
 
 	sort.Strings(nodes)
 
 	for _, node := range nodes {
-		if node == initNodeAddress {
-			// due to the bug with etcd cluster build for the init node after Reset(), skip resetting first node
-			// there's no problem if bootstrap API was used, so this check only protects legacy init nodes
-			suite.T().Log("Skipping init node", node, "due to known issue with etcd")
-
-			continue
-		}
-
 		suite.T().Log("Resetting node", node)
 
 		preReset, err := suite.HashKubeletCert(suite.ctx, node)
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/talos-systems/talos/internal/integration/base"
 )
@@ -46,6 +47,21 @@ func (suite *DmesgSuite) TestClusterHasOutput() {
 		matchers...)
 }
 
+// TestHasTalosMessages verifies that dmesg contains kernel messages logged by Talos.
+func (suite *DmesgSuite) TestHasTalosMessages() {
+	suite.RunAndWaitForMatch([]string{"dmesg", "--nodes", suite.RandomDiscoveredNode()},
+		regexp.MustCompile(`(?m)\[talos\] `),
+		30*time.Second,
+	)
+}
+
+// TestTail verifies that with --tail only new messages are returned.
+func (suite *DmesgSuite) TestTail() {
+	// without --follow there are no new messages to return
+	suite.RunCLI([]string{"dmesg", "--tail", "--nodes", suite.RandomDiscoveredNode()},
+		base.StdoutEmpty())
+}
+
 func init() {
 	allSuites = append(allSuites, new(DmesgSuite))
 }
@@ -14,15 +14,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/go-blockdevice/blockdevice/encryption"
 	"github.com/talos-systems/go-retry/retry"
 	talosnet "github.com/talos-systems/net"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/mgmt/helpers"
@@ -65,6 +69,7 @@ type upgradeSpec struct {
 	UpgradePreserve bool
 	UpgradeStage    bool
 	WithEncryption  bool
+	WithWorkload    bool
 }
 
 const (
@@ -79,6 +84,14 @@ const (
 
 var defaultNameservers = []net.IP{net.ParseIP("8.8.8.8"), net.ParseIP("1.1.1.1")}
 
+const (
+	workloadName         = "upgrade-workload"
+	workloadNamespace    = "default"
+	workloadImage        = "k8s.gcr.io/pause:3.4.1"
+	workloadReplicas     = 3
+	workloadMinAvailable = 2
+)
+
 // upgradePreviousToStable upgrades from the previous Talos release to the stable release.
 func upgradePreviousToStable() upgradeSpec {
 	return upgradeSpec{
@@ -140,6 +153,7 @@ func upgradeCurrentToCurrent() upgradeSpec {
 		WorkerNodes: DefaultSettings.WorkerNodes,
 
 		WithEncryption: true,
+		WithWorkload:   true,
 	}
 }
 
@@ -610,6 +624,143 @@ func (suite *UpgradeSuite) untaint(name string) {
 	suite.Require().NoError(err)
 }
 
+// deployWorkload deploys a test Deployment protected by a PodDisruptionBudget and waits for it to become available.
+func (suite *UpgradeSuite) deployWorkload() {
+	client, err := suite.clusterAccess.K8sClient(suite.ctx)
+	suite.Require().NoError(err)
+
+	labels := map[string]string{"app": workloadName}
+	replicas := int32(workloadReplicas)
+	minAvailable := intstr.FromInt(workloadMinAvailable)
+
+	_, err = client.PolicyV1beta1().PodDisruptionBudgets(workloadNamespace).Create(suite.ctx, &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workloadName,
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+		},
+	}, metav1.CreateOptions{})
+	suite.Require().NoError(err)
+
+	_, err = client.AppsV1().Deployments(workloadNamespace).Create(suite.ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workloadName,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  workloadName,
+							Image: workloadImage,
+						},
+					},
+					// allow pods to be scheduled on control plane nodes, so that workload can survive worker upgrades
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      constants.LabelNodeRoleMaster,
+							Operator: corev1.TolerationOpExists,
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Affinity: &corev1.Affinity{
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+								{
+									Weight: 100,
+									PodAffinityTerm: corev1.PodAffinityTerm{
+										LabelSelector: &metav1.LabelSelector{
+											MatchLabels: labels,
+										},
+										TopologyKey: corev1.LabelHostname,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(retry.Constant(5*time.Minute, retry.WithUnits(5*time.Second)).Retry(func() error {
+		var deployment *appsv1.Deployment
+
+		deployment, err = client.AppsV1().Deployments(workloadNamespace).Get(suite.ctx, workloadName, metav1.GetOptions{})
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		if deployment.Status.AvailableReplicas < replicas {
+			return retry.ExpectedError(fmt.Errorf("deployment %q has %d available replicas, expected %d", workloadName, deployment.Status.AvailableReplicas, replicas))
+		}
+
+		return nil
+	}))
+}
+
+// monitorWorkload watches availability of the test Deployment in the background.
+//
+// Returned function stops monitoring and returns the minimum number of available replicas observed.
+func (suite *UpgradeSuite) monitorWorkload() func() int32 {
+	client, err := suite.clusterAccess.K8sClient(suite.ctx)
+	suite.Require().NoError(err)
+
+	ctx, ctxCancel := context.WithCancel(suite.ctx)
+
+	var wg sync.WaitGroup
+
+	minAvailable := int32(workloadReplicas)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(2 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			deployment, e := client.AppsV1().Deployments(workloadNamespace).Get(ctx, workloadName, metav1.GetOptions{})
+			if e != nil {
+				// API server might be unavailable while control plane nodes are upgraded
+				continue
+			}
+
+			if deployment.Status.AvailableReplicas < minAvailable {
+				minAvailable = deployment.Status.AvailableReplicas
+
+				suite.T().Logf("deployment %q available replicas dropped to %d", workloadName, minAvailable)
+			}
+		}
+	}()
+
+	return func() int32 {
+		ctxCancel()
+		wg.Wait()
+
+		return minAvailable
+	}
+}
+
 // TestRolling performs rolling upgrade starting with master nodes.
 func (suite *UpgradeSuite) TestRolling() {
 	suite.setupCluster()
@@ -620,6 +771,14 @@ func (suite *UpgradeSuite) TestRolling() {
 	// verify initial cluster version
 	suite.assertSameVersionCluster(client, suite.spec.SourceVersion)
 
+	var stopWorkloadMonitor func() int32
+
+	if suite.spec.WithWorkload {
+		suite.deployWorkload()
+
+		stopWorkloadMonitor = suite.monitorWorkload()
+	}
+
 	// upgrade master nodes
 	for _, node := range suite.Cluster.Info().Nodes {
 		if node.Type == machine.TypeInit || node.Type == machine.TypeControlPlane {
@@ -634,6 +793,10 @@ func (suite *UpgradeSuite) TestRolling() {
 		}
 	}
 
+	if stopWorkloadMonitor != nil {
+		suite.Assert().GreaterOrEqual(stopWorkloadMonitor(), int32(workloadMinAvailable), "workload availability dropped below PodDisruptionBudget minimum")
+	}
+
 	// verify final cluster version
 	suite.assertSameVersionCluster(client, suite.spec.TargetVersion)
 
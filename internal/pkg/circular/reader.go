@@ -76,6 +76,11 @@ func (r *Reader) Close() error {
 	return nil
 }
 
+// StartOffset returns the offset in the Buffer (see Buffer.Offset) the reader positions are relative to.
+func (r *Reader) StartOffset() int64 {
+	return r.startOff
+}
+
 // Seek implements io.Seeker.
 func (r *Reader) Seek(offset int64, whence int) (int64, error) {
 	newOff := r.off
@@ -82,6 +82,11 @@ func (r *StreamingReader) Close() error {
 	return nil
 }
 
+// StartOffset returns the offset in the Buffer (see Buffer.Offset) the reader positions are relative to.
+func (r *StreamingReader) StartOffset() int64 {
+	return r.initialOff
+}
+
 // Seek implements io.Seeker.
 func (r *StreamingReader) Seek(offset int64, whence int) (int64, error) {
 	newOff := r.off
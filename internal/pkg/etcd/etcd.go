@@ -130,6 +130,43 @@ func (c *Client) ValidateForUpgrade(ctx context.Context, config config.Provider,
 	return nil
 }
 
+// ValidateForRemoval validates that the member with the specified hostname can be removed from the cluster.
+//
+// Removal of the only member is allowed, as there is no quorum to lose.
+// Otherwise removal is refused if the remaining healthy members can't maintain quorum.
+func ValidateForRemoval(ctx context.Context, members []*etcdserverpb.Member, hostname string) error {
+	if len(members) == 1 {
+		return nil
+	}
+
+	healthy := 0
+
+	for _, member := range members {
+		if member.Name == hostname {
+			continue
+		}
+
+		// If the member is not started, the name will be an empty string.
+		if len(member.Name) == 0 {
+			continue
+		}
+
+		if err := validateMemberHealth(ctx, member.GetClientURLs()); err != nil {
+			log.Printf("etcd member %q is not healthy: %s", member.Name, err)
+
+			continue
+		}
+
+		healthy++
+	}
+
+	if remaining := len(members) - 1; healthy < remaining/2+1 {
+		return fmt.Errorf("removing %q leaves %d healthy etcd members out of %d, which is insufficient to maintain quorum", hostname, healthy, remaining)
+	}
+
+	return nil
+}
+
 // ValidateQuorum performs a KV operation to make certain that quorum is good.
 func (c *Client) ValidateQuorum(ctx context.Context) (err error) {
 	// Get a random key. As long as we can get the response without an error, quorum is good.
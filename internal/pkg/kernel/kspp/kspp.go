@@ -6,6 +6,7 @@ package kspp
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-procfs/procfs"
@@ -24,70 +25,156 @@ var RequiredKSPPKernelParameters = procfs.Parameters{
 	procfs.NewParameter("pti").Append("on"),
 }
 
-// EnforceKSPPKernelParameters verifies that all required KSPP kernel
-// parameters are present with the right value.
-func EnforceKSPPKernelParameters() error {
-	var result *multierror.Error
+// RequiredKSPPSysctls is the set of kernel sysctls required to satisfy the KSPP.
+var RequiredKSPPSysctls = []*sysctl.SystemProperty{
+	{
+		Key:   "kernel.kptr_restrict",
+		Value: "1",
+	},
+	{
+		Key:   "kernel.dmesg_restrict",
+		Value: "1",
+	},
+	{
+		Key:   "kernel.perf_event_paranoid",
+		Value: "3",
+	},
+	// We can skip this sysctl because CONFIG_KEXEC is not set.
+	// {
+	// 	Key:   "kernel.kexec_load_disabled",
+	// 	Value: "1",
+	// },
+	{
+		Key:   "kernel.yama.ptrace_scope",
+		Value: "1",
+	},
+	{
+		Key:   "user.max_user_namespaces",
+		Value: "0",
+	},
+	{
+		Key:   "kernel.unprivileged_bpf_disabled",
+		Value: "1",
+	},
+	{
+		Key:   "net.core.bpf_jit_harden",
+		Value: "2",
+	},
+}
 
-	for _, values := range RequiredKSPPKernelParameters {
-		var val *string
-		if val = procfs.ProcCmdline().Get(values.Key()).First(); val == nil {
-			result = multierror.Append(result, fmt.Errorf("KSPP kernel parameter %s is required", values.Key()))
+// RequirementKind is the kind of the KSPP requirement.
+type RequirementKind string
 
-			continue
-		}
+// Requirement kinds.
+const (
+	KernelParameter RequirementKind = "kernel parameter"
+	Sysctl          RequirementKind = "sysctl"
+)
+
+// Requirement is the result of a single KSPP requirement check.
+type Requirement struct {
+	Kind     RequirementKind
+	Key      string
+	Expected string
+	// Actual is the value found, empty if Found is false.
+	Actual string
+	Found  bool
+}
+
+// Satisfied returns true if the requirement is met.
+func (req Requirement) Satisfied() bool {
+	return req.Found && req.Actual == req.Expected
+}
 
-		expected := values.First()
-		if *val != *expected {
-			result = multierror.Append(result, fmt.Errorf("KSPP kernel parameter %s was found with value %s, expected %s", values.Key(), *val, *expected))
+// String implements fmt.Stringer.
+func (req Requirement) String() string {
+	switch {
+	case req.Satisfied():
+		return fmt.Sprintf("KSPP %s %s=%q: ok", req.Kind, req.Key, req.Expected)
+	case !req.Found:
+		return fmt.Sprintf("KSPP %s %s=%q: not found", req.Kind, req.Key, req.Expected)
+	default:
+		return fmt.Sprintf("KSPP %s %s=%q: found %q", req.Kind, req.Key, req.Expected, req.Actual)
+	}
+}
+
+// Report is the list of checked KSPP requirements.
+type Report []Requirement
+
+// Failed returns the requirements which are not met.
+func (report Report) Failed() Report {
+	var failed Report
+
+	for _, req := range report {
+		if !req.Satisfied() {
+			failed = append(failed, req)
 		}
 	}
 
+	return failed
+}
+
+// Err returns an error listing each unmet requirement, or nil if all the requirements are met.
+func (report Report) Err() error {
+	var result *multierror.Error
+
+	for _, req := range report.Failed() {
+		result = multierror.Append(result, fmt.Errorf("%s", req))
+	}
+
 	return result.ErrorOrNil()
 }
 
-// EnforceKSPPSysctls verifies that all required KSPP kernel sysctls are set
-// with the right value.
-func EnforceKSPPSysctls() (err error) {
-	props := []*sysctl.SystemProperty{
-		{
-			Key:   "kernel.kptr_restrict",
-			Value: "1",
-		},
-		{
-			Key:   "kernel.dmesg_restrict",
-			Value: "1",
-		},
-		{
-			Key:   "kernel.perf_event_paranoid",
-			Value: "3",
-		},
-		// We can skip this sysctl because CONFIG_KEXEC is not set.
-		// {
-		// 	Key:   "kernel.kexec_load_disabled",
-		// 	Value: "1",
-		// },
-		{
-			Key:   "kernel.yama.ptrace_scope",
-			Value: "1",
-		},
-		{
-			Key:   "user.max_user_namespaces",
-			Value: "0",
-		},
-		{
-			Key:   "kernel.unprivileged_bpf_disabled",
-			Value: "1",
-		},
-		{
-			Key:   "net.core.bpf_jit_harden",
-			Value: "2",
-		},
+// Verify checks all the KSPP requirements against the running kernel.
+func Verify() Report {
+	return verify(procfs.ProcCmdline(), func(prop *sysctl.SystemProperty) (string, error) {
+		value, err := sysctl.ReadSystemProperty(prop)
+
+		return string(value), err
+	})
+}
+
+func verify(cmdline *procfs.Cmdline, readSysctl func(*sysctl.SystemProperty) (string, error)) Report {
+	report := make(Report, 0, len(RequiredKSPPKernelParameters)+len(RequiredKSPPSysctls))
+
+	for _, param := range RequiredKSPPKernelParameters {
+		req := Requirement{
+			Kind:     KernelParameter,
+			Key:      param.Key(),
+			Expected: *param.First(),
+		}
+
+		if val := cmdline.Get(param.Key()).First(); val != nil {
+			req.Found = true
+			req.Actual = *val
+		}
+
+		report = append(report, req)
 	}
 
-	for _, prop := range props {
+	for _, prop := range RequiredKSPPSysctls {
+		req := Requirement{
+			Kind:     Sysctl,
+			Key:      prop.Key,
+			Expected: prop.Value,
+		}
+
+		if val, err := readSysctl(prop); err == nil {
+			req.Found = true
+			req.Actual = strings.TrimSpace(val)
+		}
+
+		report = append(report, req)
+	}
+
+	return report
+}
+
+// EnforceKSPPSysctls sets all required KSPP kernel sysctls to the right value.
+func EnforceKSPPSysctls() (err error) {
+	for _, prop := range RequiredKSPPSysctls {
 		if err = sysctl.WriteSystemProperty(prop); err != nil {
-			return
+			return fmt.Errorf("error setting KSPP sysctl %s: %w", prop.Key, err)
 		}
 	}
 
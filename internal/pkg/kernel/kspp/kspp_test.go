@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kspp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/pkg/sysctl"
+)
+
+func TestVerify(t *testing.T) {
+	sysctls := map[string]string{
+		"kernel.kptr_restrict":             "1\n",
+		"kernel.dmesg_restrict":            "1\n",
+		"kernel.perf_event_paranoid":       "2\n",
+		"kernel.yama.ptrace_scope":         "1\n",
+		"user.max_user_namespaces":         "0\n",
+		"kernel.unprivileged_bpf_disabled": "1\n",
+	}
+
+	report := verify(procfs.NewCmdline("slab_nomerge pti=off"), func(prop *sysctl.SystemProperty) (string, error) {
+		val, ok := sysctls[prop.Key]
+		if !ok {
+			return "", fmt.Errorf("not found")
+		}
+
+		return val, nil
+	})
+
+	assert.Len(t, report, len(RequiredKSPPKernelParameters)+len(RequiredKSPPSysctls))
+
+	failed := make([]string, 0, len(report))
+
+	for _, req := range report.Failed() {
+		failed = append(failed, req.String())
+	}
+
+	assert.Equal(t, []string{
+		`KSPP kernel parameter pti="on": found "off"`,
+		`KSPP sysctl kernel.perf_event_paranoid="3": found "2"`,
+		`KSPP sysctl net.core.bpf_jit_harden="2": not found`,
+	}, failed)
+
+	assert.Error(t, report.Err())
+	assert.NoError(t, report[:1].Err())
+}
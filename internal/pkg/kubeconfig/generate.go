@@ -51,7 +51,15 @@ type GenerateAdminInput interface {
 }
 
 // GenerateAdmin generates admin kubeconfig for the cluster.
+//
+// Admin kubeconfig endpoint overrides the cluster endpoint if set.
 func GenerateAdmin(config GenerateAdminInput, out io.Writer) error {
+	endpoint := config.Endpoint()
+
+	if adminEndpoint := config.AdminKubeconfig().Endpoint(); adminEndpoint != nil {
+		endpoint = adminEndpoint
+	}
+
 	return Generate(
 		&GenerateInput{
 			ClusterName:         config.Name(),
@@ -61,7 +69,7 @@ func GenerateAdmin(config GenerateAdminInput, out io.Writer) error {
 			CommonName:   constants.KubernetesAdminCertCommonName,
 			Organization: constants.KubernetesAdminCertOrganization,
 
-			Endpoint:    config.Endpoint().String(),
+			Endpoint:    endpoint.String(),
 			Username:    "admin",
 			ContextName: "admin",
 		},
@@ -63,6 +63,65 @@ func (suite *GenerateSuite) TestGenerateAdmin() {
 	}
 }
 
+func (suite *GenerateSuite) TestGenerateAdminEndpoint() {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	clusterEndpoint, err := url.Parse("https://cluster.internal:6443")
+	suite.Require().NoError(err)
+
+	adminEndpoint, err := url.Parse("https://10.5.0.100:6443")
+	suite.Require().NoError(err)
+
+	cfg := &v1alpha1.ClusterConfig{
+		ClusterName: "talos1",
+		ClusterCA: &x509.PEMEncodedCertificateAndKey{
+			Crt: ca.CrtPEM,
+			Key: ca.KeyPEM,
+		},
+		ControlPlane: &v1alpha1.ControlPlaneConfig{
+			Endpoint: &v1alpha1.Endpoint{
+				URL: clusterEndpoint,
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		admin    *v1alpha1.AdminKubeconfigConfig
+		expected string
+	}{
+		{
+			name:     "default",
+			expected: "https://cluster.internal:6443",
+		},
+		{
+			name: "override",
+			admin: &v1alpha1.AdminKubeconfigConfig{
+				AdminKubeconfigEndpoint: &v1alpha1.Endpoint{
+					URL: adminEndpoint,
+				},
+			},
+			expected: "https://10.5.0.100:6443",
+		},
+	} {
+		tt := tt
+
+		suite.Run(tt.name, func() {
+			cfg.AdminKubeconfigConfig = tt.admin
+
+			var buf bytes.Buffer
+
+			suite.Require().NoError(kubeconfig.GenerateAdmin(cfg, &buf))
+
+			config, err := clientcmd.Load(buf.Bytes())
+			suite.Require().NoError(err)
+
+			suite.Assert().Equal(tt.expected, config.Clusters[cfg.ClusterName].Server)
+		})
+	}
+}
+
 func (suite *GenerateSuite) TestGenerate() {
 	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
 	suite.Require().NoError(err)
@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"os/exec"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 
@@ -55,6 +56,26 @@ func Format(devname string, t *FormatOptions) error {
 	}
 }
 
+// CheckFileSystemType verifies that the partition can be formatted using the filesystem type.
+func CheckFileSystemType(fsType FileSystemType) error {
+	var tool string
+
+	switch fsType {
+	case FilesystemTypeNone:
+		return nil
+	case FilesystemTypeVFAT, FilesystemTypeXFS:
+		tool = "mkfs." + fsType
+	default:
+		return fmt.Errorf("unsupported filesystem type: %q", fsType)
+	}
+
+	if _, err := exec.LookPath(tool); err != nil {
+		return fmt.Errorf("filesystem type %q is not supported on this system: %w", fsType, err)
+	}
+
+	return nil
+}
+
 // zeroPartition fills the partition with zeroes.
 func zeroPartition(devname string, size int64) (err error) {
 	log.Printf("zeroing out %q", devname)
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package redact scrubs secrets from the diagnostic data shared outside of the node.
+package redact
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Placeholder replaces redacted secrets.
+const Placeholder = "<REDACTED>"
+
+// builtinPatterns are always redacted.
+//
+// If the pattern has a capture group, only the first group is replaced.
+var builtinPatterns = []string{
+	// PEM-encoded private keys
+	`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`,
+	// key-value pairs in YAML, JSON and command line flags
+	`(?i)(?:client-key-data|private-?key|token|password|secret)"?\s*[:=]\s*"?([^\s",]+)`,
+	// HTTP authorization headers
+	`(?i)authorization:\s*(?:bearer|basic)\s+(\S+)`,
+	// Kubernetes bootstrap tokens
+	`\b[a-z0-9]{6}\.([a-z0-9]{16})\b`,
+}
+
+// Redactor replaces secrets in the data with the Placeholder.
+type Redactor struct {
+	patterns []*regexp.Regexp
+	values   [][]byte
+}
+
+// New builds a Redactor with the built-in and additional patterns.
+func New(patterns ...string) (*Redactor, error) {
+	r := &Redactor{}
+
+	for _, pattern := range append(append([]string(nil), builtinPatterns...), patterns...) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling pattern %q: %w", pattern, err)
+		}
+
+		r.patterns = append(r.patterns, re)
+	}
+
+	return r, nil
+}
+
+// ForConfig builds a Redactor with the patterns from the machine configuration
+// which also redacts the secrets of the configuration itself.
+func ForConfig(cfg config.Provider) (*Redactor, error) {
+	r, err := New(cfg.Machine().RedactPatterns()...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddValues(configSecrets(cfg)...)
+
+	return r, nil
+}
+
+// AddValues adds the secrets which are redacted wherever they appear.
+func (r *Redactor) AddValues(values ...string) {
+	for _, value := range values {
+		if value != "" {
+			r.values = append(r.values, []byte(value))
+		}
+	}
+}
+
+// Redact returns the data with the secrets replaced.
+func (r *Redactor) Redact(data []byte) []byte {
+	for _, value := range r.values {
+		data = bytes.ReplaceAll(data, value, []byte(Placeholder))
+	}
+
+	for _, re := range r.patterns {
+		data = replace(re, data)
+	}
+
+	return data
+}
+
+func replace(re *regexp.Regexp, data []byte) []byte {
+	if re.NumSubexp() == 0 {
+		return re.ReplaceAllLiteral(data, []byte(Placeholder))
+	}
+
+	matches := re.FindAllSubmatchIndex(data, -1)
+	if matches == nil {
+		return data
+	}
+
+	result := make([]byte, 0, len(data))
+	last := 0
+
+	for _, match := range matches {
+		start, end := match[2], match[3]
+		if start < 0 {
+			continue
+		}
+
+		result = append(result, data[last:start]...)
+		result = append(result, Placeholder...)
+		last = end
+	}
+
+	return append(result, data[last:]...)
+}
+
+// configSecrets returns the values of the machine configuration which should never leave the node.
+func configSecrets(cfg config.Provider) []string {
+	secrets := []string{
+		cfg.Machine().Security().Token(),
+		cfg.Cluster().Token().Secret(),
+		cfg.Cluster().AESCBCEncryptionSecret(),
+	}
+
+	for _, device := range cfg.Machine().Network().Devices() {
+		if wireguard := device.WireguardConfig(); wireguard != nil {
+			secrets = append(secrets, wireguard.PrivateKey())
+		}
+	}
+
+	var keys [][]byte
+
+	for _, ca := range append([]*x509.PEMEncodedCertificateAndKey{
+		cfg.Machine().Security().CA(),
+		cfg.Cluster().CA(),
+		cfg.Cluster().AggregatorCA(),
+		cfg.Cluster().Etcd().CA(),
+	}, cfg.Machine().Security().AcceptedCAs()...) {
+		if ca != nil {
+			keys = append(keys, ca.Key)
+		}
+	}
+
+	for _, key := range append([]*x509.PEMEncodedKey{cfg.Cluster().ServiceAccount()}, cfg.Cluster().ServiceAccountAdditionalKeys()...) {
+		if key != nil {
+			keys = append(keys, key.Key)
+		}
+	}
+
+	for _, registry := range cfg.Machine().Registries().Config() {
+		if auth := registry.Auth(); auth != nil {
+			secrets = append(secrets, auth.Password(), auth.Auth(), auth.IdentityToken())
+		}
+
+		if tlsConfig := registry.TLS(); tlsConfig != nil && tlsConfig.ClientIdentity() != nil {
+			keys = append(keys, tlsConfig.ClientIdentity().Key)
+		}
+	}
+
+	// keys are base64-encoded in the configuration
+	for _, key := range keys {
+		if len(key) > 0 {
+			secrets = append(secrets, base64.StdEncoding.EncodeToString(key))
+		}
+	}
+
+	return secrets
+}
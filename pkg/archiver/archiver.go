@@ -31,7 +31,7 @@ func TarGz(ctx context.Context, rootPath string, output io.Writer, walkerOptions
 }
 
 // UntarGz extracts .tar.gz archive to the rootPath.
-func UntarGz(ctx context.Context, input io.Reader, rootPath string) error {
+func UntarGz(ctx context.Context, input io.Reader, rootPath string, untarOptions ...UntarOption) error {
 	zr, err := gzip.NewReader(input)
 	if err != nil {
 		return err
@@ -40,7 +40,7 @@ func UntarGz(ctx context.Context, input io.Reader, rootPath string) error {
 	//nolint:errcheck
 	defer zr.Close()
 
-	err = Untar(ctx, zr, rootPath)
+	err = Untar(ctx, zr, rootPath, untarOptions...)
 	if err != nil {
 		return err
 	}
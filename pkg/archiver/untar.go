@@ -9,18 +9,46 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"path/filepath"
 
 	"github.com/talos-systems/talos/pkg/safepath"
 )
 
+type untarOptions struct {
+	strictOwnership bool
+}
+
+// UntarOption configures Untar.
+type UntarOption func(*untarOptions)
+
+// WithStrictOwnership makes failures to restore the file ownership fatal.
+//
+// By default, such failures are logged and ignored, as changing the ownership requires privileges.
+func WithStrictOwnership() UntarOption {
+	return func(o *untarOptions) {
+		o.strictOwnership = true
+	}
+}
+
 // Untar extracts .tar archive from r into filesystem under rootPath.
 //
-//nolint:gocyclo
-func Untar(ctx context.Context, r io.Reader, rootPath string) error {
+// File modes, ownership and modification times are restored from the archive.
+//
+//nolint:gocyclo,cyclop
+func Untar(ctx context.Context, r io.Reader, rootPath string, setters ...UntarOption) error {
+	var opts untarOptions
+
+	for _, setter := range setters {
+		setter(&opts)
+	}
+
 	tr := tar.NewReader(r)
 
+	// directory modes and times are restored once all the contents are extracted
+	var dirs []*tar.Header
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -53,15 +81,48 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 				return fmt.Errorf("error creating directory %q mode %s: %w", path, mode, err)
 			}
 
+			if err = opts.chown(path, hdr); err != nil {
+				return err
+			}
+
 			if err = os.Chmod(path, mode); err != nil {
 				return fmt.Errorf("error updating mode %s for %q: %w", mode, path, err)
 			}
 
+			dirs = append(dirs, hdr)
+
 		case tar.TypeSymlink:
+			if err = removeExisting(path); err != nil {
+				return err
+			}
+
 			if err = os.Symlink(hdr.Linkname, path); err != nil {
 				return fmt.Errorf("error creating symlink %q -> %q: %w", path, hdr.Linkname, err)
 			}
 
+			if err = opts.chown(path, hdr); err != nil {
+				return err
+			}
+
+		case tar.TypeLink:
+			linkPath := safepath.CleanPath(hdr.Linkname)
+			if linkPath == "" {
+				return fmt.Errorf("empty hardlink target for %q", path)
+			}
+
+			target := filepath.Join(rootPath, linkPath)
+
+			if err = removeExisting(path); err != nil {
+				return err
+			}
+
+			if err = os.Link(target, path); err != nil {
+				return fmt.Errorf("error creating hardlink %q -> %q: %w", path, target, err)
+			}
+
+		case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			return fmt.Errorf("error extracting %q: device nodes and named pipes are not supported", path)
+
 		default:
 			mode := hdr.FileInfo().Mode()
 
@@ -79,11 +140,56 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 				return fmt.Errorf("error closing %q: %w", path, err)
 			}
 
+			// ownership is changed before the mode, as chown clears setuid and setgid bits
+			if err = opts.chown(path, hdr); err != nil {
+				return err
+			}
+
 			if err = os.Chmod(path, mode); err != nil {
 				return fmt.Errorf("error updating mode %s for %q: %w", mode, path, err)
 			}
+
+			if err = os.Chtimes(path, hdr.ModTime, hdr.ModTime); err != nil {
+				return fmt.Errorf("error updating times for %q: %w", path, err)
+			}
+		}
+	}
+
+	// restore in reverse order, so that the parent directory is updated after its subdirectories
+	for i := len(dirs) - 1; i >= 0; i-- {
+		hdr := dirs[i]
+		path := filepath.Join(rootPath, safepath.CleanPath(hdr.Name))
+		mode := hdr.FileInfo().Mode()
+
+		if err := os.Chmod(path, mode); err != nil {
+			return fmt.Errorf("error updating mode %s for %q: %w", mode, path, err)
+		}
+
+		if err := os.Chtimes(path, hdr.ModTime, hdr.ModTime); err != nil {
+			return fmt.Errorf("error updating times for %q: %w", path, err)
 		}
 	}
 
 	return nil
 }
+
+func (o *untarOptions) chown(path string, hdr *tar.Header) error {
+	if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+		if o.strictOwnership {
+			return fmt.Errorf("error changing ownership of %q to %d:%d: %w", path, hdr.Uid, hdr.Gid, err)
+		}
+
+		log.Printf("ignoring error changing ownership of %q to %d:%d: %s", path, hdr.Uid, hdr.Gid, err)
+	}
+
+	return nil
+}
+
+// removeExisting removes the file at path (if it exists) so that it can be replaced with a link.
+func removeExisting(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing existing %q: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archiver_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+)
+
+func buildTar(t *testing.T, headers ...*tar.Header) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	for _, hdr := range headers {
+		require.NoError(t, tw.WriteHeader(hdr))
+
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := tw.Write(bytes.Repeat([]byte("x"), int(hdr.Size)))
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, tw.Close())
+
+	return &buf
+}
+
+func TestUntarLinks(t *testing.T) {
+	dir := t.TempDir()
+
+	// pre-existing file should be replaced with the symlink
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "bin"), 0o755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bin", "sh"), []byte("old"), 0o644))
+
+	buf := buildTar(t,
+		&tar.Header{Typeflag: tar.TypeDir, Name: "lib/", Mode: 0o755},
+		&tar.Header{Typeflag: tar.TypeReg, Name: "bin/busybox", Mode: 0o755, Size: 4},
+		&tar.Header{Typeflag: tar.TypeSymlink, Name: "bin/sh", Linkname: "busybox"},
+		&tar.Header{Typeflag: tar.TypeLink, Name: "bin/ash", Linkname: "bin/busybox"},
+	)
+
+	require.NoError(t, archiver.Untar(context.Background(), buf, dir))
+
+	target, err := os.Readlink(filepath.Join(dir, "bin", "sh"))
+	require.NoError(t, err)
+	assert.Equal(t, "busybox", target)
+
+	original, err := os.Stat(filepath.Join(dir, "bin", "busybox"))
+	require.NoError(t, err)
+
+	link, err := os.Stat(filepath.Join(dir, "bin", "ash"))
+	require.NoError(t, err)
+
+	assert.True(t, os.SameFile(original, link))
+}
+
+func TestUntarDeviceNode(t *testing.T) {
+	buf := buildTar(t,
+		&tar.Header{Typeflag: tar.TypeChar, Name: "dev/null", Mode: 0o666, Devmajor: 1, Devminor: 3},
+	)
+
+	assert.Error(t, archiver.Untar(context.Background(), buf, t.TempDir()))
+}
+
+func TestUntarMetadata(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	buf := buildTar(t,
+		&tar.Header{Typeflag: tar.TypeDir, Name: "bin/", Mode: 0o750, Uid: 1000, Gid: 1000, ModTime: modTime},
+		&tar.Header{Typeflag: tar.TypeReg, Name: "bin/su", Mode: 0o4755, Uid: 1000, Gid: 1000, ModTime: modTime, Size: 4},
+	)
+
+	require.NoError(t, archiver.Untar(context.Background(), buf, dir))
+
+	for _, tt := range []struct {
+		path string
+		mode os.FileMode
+	}{
+		{"bin", os.ModeDir | 0o750},
+		{"bin/su", os.ModeSetuid | 0o755},
+	} {
+		st, err := os.Stat(filepath.Join(dir, tt.path))
+		require.NoError(t, err)
+
+		assert.Equal(t, tt.mode, st.Mode(), tt.path)
+		assert.True(t, modTime.Equal(st.ModTime()), tt.path)
+
+		if os.Getuid() == 0 {
+			assert.EqualValues(t, 1000, st.Sys().(*syscall.Stat_t).Uid, tt.path)
+			assert.EqualValues(t, 1000, st.Sys().(*syscall.Stat_t).Gid, tt.path)
+		}
+	}
+}
+
+func TestUntarStrictOwnership(t *testing.T) {
+	if os.Getuid() == 0 {
+		t.Skip("changing ownership always succeeds for root")
+	}
+
+	buf := buildTar(t,
+		&tar.Header{Typeflag: tar.TypeReg, Name: "file", Mode: 0o644, Uid: 1, Gid: 1, Size: 4},
+	)
+
+	assert.Error(t, archiver.Untar(context.Background(), buf, t.TempDir(), archiver.WithStrictOwnership()))
+}
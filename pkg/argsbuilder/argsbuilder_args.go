@@ -4,7 +4,10 @@
 
 package argsbuilder
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // Key represents an arg key.
 type Key = string
@@ -32,11 +35,21 @@ func (a Args) Set(k, v Key) ArgsBuilder {
 }
 
 // Args implements the ArgsBuilder interface.
+//
+// Args are sorted by key to produce stable output.
 func (a Args) Args() []string {
-	args := []string{}
+	keys := make([]string, 0, len(a))
 
-	for key, val := range a {
-		args = append(args, fmt.Sprintf("--%s=%s", key, val))
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(a))
+
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("--%s=%s", key, a[key]))
 	}
 
 	return args
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package buffer implements chunker.Chunker for in-memory data.
+package buffer
+
+import (
+	"context"
+
+	"github.com/talos-systems/talos/pkg/chunker"
+)
+
+// Options is the functional options struct.
+type Options struct {
+	Size int
+}
+
+// Option is the functional option func.
+type Option func(*Options)
+
+// WithSize sets the chunk size of the Chunker.
+func WithSize(s int) Option {
+	return func(args *Options) {
+		args.Size = s
+	}
+}
+
+// Buffer is a concrete type that implements the chunker.Chunker interface.
+//
+// Each call to Read streams the whole data from the beginning, so Buffer is handy
+// to test the consumers of the chunker.Chunker without relying on the timing.
+type Buffer struct {
+	data    []byte
+	options *Options
+
+	ctx context.Context
+}
+
+// NewChunker initializes a Chunker with default values.
+func NewChunker(ctx context.Context, data []byte, setters ...Option) chunker.Chunker {
+	opts := &Options{
+		Size: 1024,
+	}
+
+	for _, setter := range setters {
+		setter(opts)
+	}
+
+	return &Buffer{
+		data,
+		opts,
+		ctx,
+	}
+}
+
+// Read implements ChunkReader.
+func (c *Buffer) Read() <-chan []byte {
+	// Create a buffered channel of length 1.
+	ch := make(chan []byte, 1)
+
+	go func(ch chan []byte) {
+		defer close(ch)
+
+		for data := c.data; len(data) > 0; {
+			select {
+			case <-c.ctx.Done():
+				return
+			default:
+			}
+
+			n := c.options.Size
+			if n > len(data) {
+				n = len(data)
+			}
+
+			// Copy the chunk so that consumers can't modify the data.
+			b := make([]byte, n)
+			copy(b, data[:n])
+
+			data = data[n:]
+
+			select {
+			case <-c.ctx.Done():
+				return
+			case ch <- b:
+			}
+		}
+	}(ch)
+
+	return ch
+}
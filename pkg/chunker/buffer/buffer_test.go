@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package buffer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/chunker/buffer"
+)
+
+func TestRead(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	c := buffer.NewChunker(ctx, []byte("abcdefghijklmno"), buffer.WithSize(4))
+
+	// each Read streams the data from the beginning
+	for i := 0; i < 2; i++ {
+		var chunks []string
+
+		for chunk := range c.Read() {
+			chunks = append(chunks, string(chunk))
+		}
+
+		assert.Equal(t, []string{"abcd", "efgh", "ijkl", "mno"}, chunks)
+	}
+}
+
+func TestReadEmpty(t *testing.T) {
+	c := buffer.NewChunker(context.Background(), nil)
+
+	_, ok := <-c.Read()
+	assert.False(t, ok)
+}
+
+func TestReadCancel(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	c := buffer.NewChunker(ctx, []byte("abcdefghijklmno"), buffer.WithSize(1))
+
+	ch := c.Read()
+
+	chunk, ok := <-ch
+	require.True(t, ok)
+	assert.Equal(t, []byte("a"), chunk)
+
+	ctxCancel()
+
+	// the chunk buffered in the channel and the one being sent might still be delivered
+	n := 0
+
+	for range ch {
+		n++
+	}
+
+	assert.LessOrEqual(t, n, 2)
+}
@@ -109,5 +109,12 @@ func DefaultClusterChecks() []ClusterCheck {
 //
 // ExtraClusterChecks can't be used reliably in upgrade tests, as older versions might not pass the checks.
 func ExtraClusterChecks() []ClusterCheck {
-	return []ClusterCheck{}
+	return []ClusterCheck{
+		// wait for the control plane static pods to be running on all control plane nodes
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("control plane static pods to be running", func(ctx context.Context) error {
+				return K8sControlPlaneStaticPodsAssertion(ctx, cluster)
+			}, 5*time.Minute, 5*time.Second)
+		},
+	}
 }
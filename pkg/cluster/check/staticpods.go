@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+	"github.com/talos-systems/talos/pkg/resources/v1alpha1"
+)
+
+// K8sControlPlaneStaticPodsAssertion checks whether the control plane static pods are running on all the control plane nodes.
+//
+// Pod status is reported by the kubelet, so the check doesn't depend on the Kubernetes API server.
+// Nodes running self-hosted control plane are skipped.
+func K8sControlPlaneStaticPodsAssertion(ctx context.Context, cluster ClusterInfo) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := append(cluster.NodesByType(machine.TypeInit), cluster.NodesByType(machine.TypeControlPlane)...)
+
+	var multiErr *multierror.Error
+
+	for _, node := range nodes {
+		nodeCtx := client.WithNodes(ctx, node)
+
+		selfHosted, err := isSelfHostedControlPlane(nodeCtx, cli)
+		if err != nil {
+			return fmt.Errorf("%s: %w", node, err)
+		}
+
+		if selfHosted {
+			continue
+		}
+
+		statuses, err := staticPodStatuses(nodeCtx, cli)
+		if err != nil {
+			return fmt.Errorf("%s: %w", node, err)
+		}
+
+		for _, app := range k8s.ControlPlaneStaticPods {
+			status, ok := findStaticPodStatus(statuses, app)
+			if !ok {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%s: static pod %q is not running", node, app))
+
+				continue
+			}
+
+			if !k8s.PodReady(status) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%s: static pod %q is not ready: phase %s", node, app, status.Phase))
+			}
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func isSelfHostedControlPlane(ctx context.Context, cli *client.Client) (bool, error) {
+	resources, err := cli.Resources.Get(ctx, v1alpha1.NamespaceName, v1alpha1.BootstrapStatusType, v1alpha1.BootstrapStatusID)
+	if err != nil {
+		return false, fmt.Errorf("error fetching bootstrapStatus resource: %w", err)
+	}
+
+	if len(resources) != 1 {
+		return false, fmt.Errorf("expected 1 instance of bootstrapStatus resource, got %d", len(resources))
+	}
+
+	selfHosted, _ := resources[0].Resource.(*resource.Any).Value().(map[string]interface{})["selfHostedControlPlane"].(bool) //nolint:errcheck,forcetypeassert
+
+	return selfHosted, nil
+}
+
+// staticPodStatuses returns static pod statuses reported by the kubelet indexed by the pod name.
+func staticPodStatuses(ctx context.Context, cli *client.Client) (map[string]*v1.PodStatus, error) {
+	listClient, err := cli.Resources.List(ctx, k8s.ControlPlaneNamespaceName, k8s.StaticPodStatusType)
+	if err != nil {
+		return nil, fmt.Errorf("error listing static pod statuses: %w", err)
+	}
+
+	statuses := map[string]*v1.PodStatus{}
+
+	for {
+		resp, err := listClient.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing static pod statuses: %w", err)
+		}
+
+		if resp.Resource == nil {
+			continue
+		}
+
+		// resource spec is the YAML representation of the pod status, round-trip it via JSON to get typed status
+		data, err := json.Marshal(resp.Resource.(*resource.Any).Value())
+		if err != nil {
+			return nil, err
+		}
+
+		var status v1.PodStatus
+
+		if err = json.Unmarshal(data, &status); err != nil {
+			return nil, fmt.Errorf("error decoding static pod status %q: %w", resp.Resource.Metadata().ID(), err)
+		}
+
+		// resource ID is <namespace>/<pod name>
+		id := resp.Resource.Metadata().ID()
+		statuses[id[strings.Index(id, "/")+1:]] = &status
+	}
+
+	return statuses, nil
+}
+
+// findStaticPodStatus finds the status of the static pod by the app name.
+func findStaticPodStatus(statuses map[string]*v1.PodStatus, app string) (*v1.PodStatus, bool) {
+	for name, status := range statuses {
+		if k8s.IsMirrorPodOf(name, app) {
+			return status, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,34 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check //nolint:testpackage // to test unexported functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestFindStaticPodStatus(t *testing.T) {
+	apiServer := &v1.PodStatus{Phase: v1.PodRunning}
+	scheduler := &v1.PodStatus{Phase: v1.PodPending}
+
+	statuses := map[string]*v1.PodStatus{
+		"kube-apiserver-master-1": apiServer,
+		"kube-scheduler-master-1": scheduler,
+		"kube-proxy-x7f2k":        {Phase: v1.PodRunning},
+	}
+
+	status, ok := findStaticPodStatus(statuses, "kube-apiserver")
+	assert.True(t, ok)
+	assert.Same(t, apiServer, status)
+
+	status, ok = findStaticPodStatus(statuses, "kube-scheduler")
+	assert.True(t, ok)
+	assert.Same(t, scheduler, status)
+
+	_, ok = findStaticPodStatus(statuses, "kube-controller-manager")
+	assert.False(t, ok)
+}
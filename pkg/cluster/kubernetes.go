@@ -6,7 +6,9 @@ package cluster
 
 import (
 	"context"
-	"fmt"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/client-go/kubernetes"
@@ -68,7 +70,8 @@ func (k *KubernetesClient) K8sRestConfig(ctx context.Context) (*rest.Config, err
 	config.Timeout = time.Minute
 
 	if k.ForceEndpoint != "" {
-		config.Host = fmt.Sprintf("%s:%d", k.ForceEndpoint, constants.DefaultControlPlanePort)
+		// IPv6 endpoint might be already enclosed in brackets, JoinHostPort adds them back
+		config.Host = net.JoinHostPort(strings.Trim(k.ForceEndpoint, "[]"), strconv.Itoa(constants.DefaultControlPlanePort))
 	}
 
 	return config, nil
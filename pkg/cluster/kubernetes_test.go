@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster //nolint:testpackage // to test unexported field(s)
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://10.5.0.2:6443
+contexts:
+- name: admin@test
+  context:
+    cluster: test
+    user: admin@test
+current-context: admin@test
+users:
+- name: admin@test
+  user:
+    token: foo
+`
+
+func TestK8sRestConfigForceEndpoint(t *testing.T) {
+	for _, tt := range []struct {
+		endpoint     string
+		expectedHost string
+	}{
+		{endpoint: "", expectedHost: "https://10.5.0.2:6443"},
+		{endpoint: "10.5.0.3", expectedHost: "10.5.0.3:6443"},
+		{endpoint: "example.com", expectedHost: "example.com:6443"},
+		{endpoint: "fd00::1", expectedHost: "[fd00::1]:6443"},
+		{endpoint: "[fd00::1]", expectedHost: "[fd00::1]:6443"},
+	} {
+		k := &KubernetesClient{
+			ForceEndpoint: tt.endpoint,
+			kubeconfig:    []byte(testKubeconfig),
+		}
+
+		config, err := k.K8sRestConfig(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, tt.expectedHost, config.Host, "endpoint %q", tt.endpoint)
+	}
+}
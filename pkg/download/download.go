@@ -5,15 +5,24 @@
 package download
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/talos-systems/go-retry/retry"
+	"golang.org/x/net/http/httpproxy"
 )
 
 const b64 = "base64"
@@ -24,6 +33,11 @@ type downloadOptions struct {
 
 	ErrorOnNotFound      error
 	ErrorOnEmptyResponse error
+
+	RateLimiter RateLimiter
+	Proxy       *httpproxy.Config
+
+	Timeout time.Duration
 }
 
 // Option configures the download options.
@@ -32,6 +46,7 @@ type Option func(*downloadOptions)
 func downloadDefaults() *downloadOptions {
 	return &downloadOptions{
 		Headers: make(map[string]string),
+		Timeout: 180 * time.Second,
 	}
 }
 
@@ -71,15 +86,54 @@ func WithErrorOnEmptyResponse(e error) Option {
 	}
 }
 
+// WithRateLimit limits the download speed with the limiter counting bytes (see NewRateLimiter).
+func WithRateLimit(limiter RateLimiter) Option {
+	return func(d *downloadOptions) {
+		d.RateLimiter = limiter
+	}
+}
+
+// WithProxy specifies the proxy to use instead of the one from the environment variables.
+//
+// NoProxy list of the config is honored, so that the internal mirrors can be reached directly.
+func WithProxy(proxy *httpproxy.Config) Option {
+	return func(d *downloadOptions) {
+		d.Proxy = proxy
+	}
+}
+
+// WithTimeout limits the time spent retrying the download.
+func WithTimeout(timeout time.Duration) Option {
+	return func(d *downloadOptions) {
+		d.Timeout = timeout
+	}
+}
+
 // Download downloads a config.
+//
+// If the endpoint has a `#sha256=<hex>` or `#sha512=<hex>` fragment, the downloaded data is verified
+// against the digest.
 func Download(ctx context.Context, endpoint string, opts ...Option) (b []byte, err error) {
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return b, err
 	}
 
+	sum, err := parseChecksum(u.Fragment)
+	if err != nil {
+		return b, err
+	}
+
 	if u.Scheme == "file" {
-		return ioutil.ReadFile(u.Path)
+		if b, err = ioutil.ReadFile(u.Path); err != nil {
+			return nil, err
+		}
+
+		if err = sum.verify(u, b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
 	}
 
 	dlOpts := downloadDefaults()
@@ -98,7 +152,7 @@ func Download(ctx context.Context, endpoint string, opts ...Option) (b []byte, e
 		req.Header.Set(k, v)
 	}
 
-	err = retry.Exponential(180*time.Second, retry.WithUnits(time.Second), retry.WithJitter(time.Second), retry.WithErrorLogging(true)).Retry(func() error {
+	err = retry.Exponential(dlOpts.Timeout, retry.WithUnits(time.Second), retry.WithJitter(time.Second), retry.WithErrorLogging(true)).Retry(func() error {
 		select {
 		case <-ctx.Done():
 			return context.Canceled
@@ -106,8 +160,12 @@ func Download(ctx context.Context, endpoint string, opts ...Option) (b []byte, e
 		}
 
 		b, err = download(req, dlOpts)
+		if err != nil {
+			return err
+		}
 
-		return err
+		// retry on mismatch, as the data might have been truncated or corrupted in transit
+		return retry.ExpectedError(sum.verify(u, b))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to download config from %q: %w", u.String(), err)
@@ -130,6 +188,19 @@ func Download(ctx context.Context, endpoint string, opts ...Option) (b []byte, e
 func download(req *http.Request, dlOpts *downloadOptions) (data []byte, err error) {
 	client := &http.Client{}
 
+	if dlOpts.Proxy != nil {
+		proxyFunc := dlOpts.Proxy.ProxyFunc()
+
+		transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+		// registered protocols are not cloned
+		transport.RegisterProtocol("tftp", NewTFTPTransport())
+
+		client.Transport = transport
+	}
+
 	resp, err := client.Do(req)
 	if err != nil {
 		return data, retry.ExpectedError(err)
@@ -142,10 +213,26 @@ func download(req *http.Request, dlOpts *downloadOptions) (data []byte, err erro
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return data, retry.ExpectedError(fmt.Errorf("failed to download config, received %d", resp.StatusCode))
+		err = fmt.Errorf("failed to download config, received %d", resp.StatusCode)
+
+		if !retryableStatus(resp.StatusCode) {
+			return data, err
+		}
+
+		return data, retry.ExpectedError(err)
+	}
+
+	var body io.Reader = resp.Body
+
+	if dlOpts.RateLimiter != nil {
+		body = &rateLimitedReader{
+			ctx:     req.Context(),
+			r:       resp.Body,
+			limiter: dlOpts.RateLimiter,
+		}
 	}
 
-	data, err = ioutil.ReadAll(resp.Body)
+	data, err = ioutil.ReadAll(body)
 	if err != nil {
 		return data, retry.ExpectedError(fmt.Errorf("read config: %s", err.Error()))
 	}
@@ -157,6 +244,74 @@ func download(req *http.Request, dlOpts *downloadOptions) (data []byte, err erro
 	return data, nil
 }
 
+// retryableStatus returns true if the request might succeed later.
+//
+// Client errors fail fast except for 404, as the config might be not published yet.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusNotFound, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return code < 400 || code >= 500
+	}
+}
+
+type checksum struct {
+	algorithm string
+	digest    []byte
+	newHash   func() hash.Hash
+}
+
+func parseChecksum(fragment string) (*checksum, error) {
+	parts := strings.SplitN(fragment, "=", 2)
+	if len(parts) != 2 {
+		return nil, nil
+	}
+
+	sum := &checksum{
+		algorithm: parts[0],
+	}
+
+	switch sum.algorithm {
+	case "sha256":
+		sum.newHash = sha256.New
+	case "sha512":
+		sum.newHash = sha512.New
+	default:
+		// not a checksum
+		return nil, nil
+	}
+
+	var err error
+
+	if sum.digest, err = hex.DecodeString(parts[1]); err != nil {
+		return nil, fmt.Errorf("invalid %s checksum %q: %w", sum.algorithm, parts[1], err)
+	}
+
+	if len(sum.digest) != sum.newHash().Size() {
+		return nil, fmt.Errorf("invalid %s checksum %q: unexpected length", sum.algorithm, parts[1])
+	}
+
+	return sum, nil
+}
+
+func (sum *checksum) verify(u *url.URL, data []byte) error {
+	if sum == nil {
+		return nil
+	}
+
+	h := sum.newHash()
+	h.Write(data) //nolint:errcheck
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, sum.digest) {
+		log.Printf("%s checksum mismatch for %q: expected %x, computed %x", sum.algorithm, u.Redacted(), sum.digest, actual)
+
+		return fmt.Errorf("%s checksum mismatch for %q", sum.algorithm, u.Redacted())
+	}
+
+	return nil
+}
+
 func init() {
 	transport := (http.DefaultTransport.(*http.Transport))
 	transport.RegisterProtocol("tftp", NewTFTPTransport())
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package download_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http/httpproxy"
+
+	"github.com/talos-systems/talos/pkg/download"
+)
+
+func TestDownloadChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("version: v1alpha1\n"), 0o600))
+
+	for _, tt := range []struct {
+		name          string
+		fragment      string
+		expectedError string
+	}{
+		{
+			name: "NoChecksum",
+		},
+		{
+			name:     "SHA256",
+			fragment: "#sha256=77689ea067a45a7f2208c538c51557033e58dc7de18e8f1898e3604b12e16dc1",
+		},
+		{
+			name:          "SHA256Mismatch",
+			fragment:      "#sha256=0000000000000000000000000000000000000000000000000000000000000000",
+			expectedError: "sha256 checksum mismatch",
+		},
+		{
+			name:          "SHA512Invalid",
+			fragment:      "#sha512=abcd",
+			expectedError: "unexpected length",
+		},
+		{
+			name:     "NotChecksum",
+			fragment: "#section",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := download.Download(context.Background(), "file://"+path+tt.fragment)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "version: v1alpha1\n", string(b))
+		})
+	}
+}
+
+func TestDownloadClientError(t *testing.T) {
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	defer srv.Close()
+
+	_, err := download.Download(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "received 403")
+	assert.Equal(t, 1, requests)
+}
+
+// countingLimiter records the reads instead of delaying them.
+type countingLimiter struct {
+	waits []int
+}
+
+func (l *countingLimiter) Burst() int {
+	return 1000
+}
+
+func (l *countingLimiter) WaitN(ctx context.Context, n int) error {
+	l.waits = append(l.waits, n)
+
+	return nil
+}
+
+func TestDownloadRateLimit(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 3000)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data) //nolint:errcheck
+	}))
+
+	defer srv.Close()
+
+	limiter := &countingLimiter{}
+
+	b, err := download.Download(context.Background(), srv.URL, download.WithRateLimit(limiter))
+	require.NoError(t, err)
+	assert.Equal(t, data, b)
+
+	var total int
+
+	// every byte is accounted for, and the reads never exceed the burst
+	for _, n := range limiter.waits {
+		assert.LessOrEqual(t, n, limiter.Burst())
+
+		total += n
+	}
+
+	assert.Equal(t, len(data), total)
+}
+
+func TestDownloadProxy(t *testing.T) {
+	var proxied []string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+
+		w.Write([]byte("proxied")) //nolint:errcheck
+	}))
+
+	defer proxy.Close()
+
+	b, err := download.Download(context.Background(), "http://config.example.com/config.yaml", download.WithProxy(&httpproxy.Config{
+		HTTPProxy: proxy.URL,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "proxied", string(b))
+	assert.Equal(t, []string{"http://config.example.com/config.yaml"}, proxied)
+
+	// loopback addresses are never proxied, so the direct server listens on a non-loopback address
+	ip := nonLoopbackIP(t)
+
+	direct := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("direct")) //nolint:errcheck
+	}))
+
+	direct.Listener.Close() //nolint:errcheck
+
+	direct.Listener, err = net.Listen("tcp", net.JoinHostPort(ip.String(), "0"))
+	require.NoError(t, err)
+
+	direct.Start()
+	defer direct.Close()
+
+	b, err = download.Download(context.Background(), direct.URL+"/config.yaml", download.WithProxy(&httpproxy.Config{
+		HTTPProxy: proxy.URL,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "proxied", string(b))
+	assert.Equal(t, []string{"http://config.example.com/config.yaml", direct.URL + "/config.yaml"}, proxied)
+
+	b, err = download.Download(context.Background(), direct.URL+"/config.yaml", download.WithProxy(&httpproxy.Config{
+		HTTPProxy: proxy.URL,
+		NoProxy:   ip.String(),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "direct", string(b))
+	assert.Len(t, proxied, 2)
+}
+
+func nonLoopbackIP(t *testing.T) net.IP {
+	addrs, err := net.InterfaceAddrs()
+	require.NoError(t, err)
+
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return ipNet.IP
+		}
+	}
+
+	t.Skip("no non-loopback IPv4 address")
+
+	return nil
+}
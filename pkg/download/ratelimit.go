@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package download
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter limits the download speed, the tokens are the bytes read.
+//
+// *rate.Limiter created with NewRateLimiter implements the interface.
+type RateLimiter interface {
+	Burst() int
+	WaitN(ctx context.Context, n int) error
+}
+
+// NewRateLimiter creates a limiter for the downloads in bytes per second.
+//
+// The limiter might be shared between the downloads (see WithRateLimit), so that the limit
+// is applied to the total bandwidth of all the concurrent downloads.
+func NewRateLimiter(bytesPerSecond int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)
+}
+
+// rateLimitedReader delays the reads to keep the rate within the limit.
+type rateLimitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter RateLimiter
+}
+
+// Read implements io.Reader.
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	// the read can't be larger than the limiter burst, otherwise WaitN fails
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
@@ -5,6 +5,7 @@
 package factory
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -44,6 +45,7 @@ type Options struct {
 	LogPrefix          string
 	LogDestination     io.Writer
 	Reflection         bool
+	ListenSubnets      []*net.IPNet
 }
 
 // Option is the functional option func.
@@ -120,6 +122,15 @@ func WithReflection() Option {
 	}
 }
 
+// ListenSubnets restricts TCP listener to the connections to the local addresses in the subnets.
+//
+// Connections over the loopback interface are always accepted.
+func ListenSubnets(o ...*net.IPNet) Option {
+	return func(args *Options) {
+		args.ListenSubnets = append(args.ListenSubnets, o...)
+	}
+}
+
 func recoveryHandler(logger *log.Logger) grpc_recovery.RecoveryHandlerFunc {
 	return func(p interface{}) error {
 		if logger != nil {
@@ -215,7 +226,40 @@ func NewListener(setters ...Option) (net.Listener, error) {
 		return nil, fmt.Errorf("unknown network: %s", opts.Network)
 	}
 
-	return net.Listen(opts.Network, address)
+	listener, err := net.Listen(opts.Network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Network == "tcp" && len(opts.ListenSubnets) > 0 {
+		listener = &subnetListener{
+			Listener: listener,
+			subnets:  opts.ListenSubnets,
+		}
+	}
+
+	return listener, nil
+}
+
+// ServerGracefulStop stops the server gracefully waiting for the in-flight calls to complete.
+//
+// Server is stopped forcibly if the calls are not complete by the time ctx is canceled,
+// e.g. with streaming calls which never end on their own.
+func ServerGracefulStop(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-ctx.Done():
+		server.Stop()
+
+		<-stopped
+	case <-stopped:
+	}
 }
 
 // ListenAndServe configures TLS for mutual authentication by loading the CA into a
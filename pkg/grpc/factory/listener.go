@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package factory
+
+import (
+	"net"
+)
+
+// subnetListener closes accepted connections if either the local or the peer address is outside of the subnets.
+type subnetListener struct {
+	net.Listener
+
+	subnets []*net.IPNet
+}
+
+// Accept implements net.Listener.
+func (l *subnetListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		if l.allowed(conn.LocalAddr()) && l.allowed(conn.RemoteAddr()) {
+			return conn, nil
+		}
+
+		conn.Close() //nolint:errcheck
+	}
+}
+
+func (l *subnetListener) allowed(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+
+	if tcpAddr.IP.IsLoopback() {
+		return true
+	}
+
+	for _, subnet := range l.subnets {
+		if subnet.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+
+	return false
+}
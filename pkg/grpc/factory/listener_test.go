@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package factory
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockConn struct {
+	net.Conn
+
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	closed     bool
+}
+
+func (c *mockConn) LocalAddr() net.Addr {
+	return c.localAddr
+}
+
+func (c *mockConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *mockConn) Close() error {
+	c.closed = true
+
+	return nil
+}
+
+type mockListener struct {
+	net.Listener
+
+	conns []*mockConn
+}
+
+func (l *mockListener) Accept() (net.Conn, error) {
+	if len(l.conns) == 0 {
+		return nil, io.EOF
+	}
+
+	conn := l.conns[0]
+	l.conns = l.conns[1:]
+
+	return conn, nil
+}
+
+func TestSubnetListener(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("192.0.2.0/24")
+	require.NoError(t, err)
+
+	outside := &mockConn{
+		localAddr:  &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 50000},
+		remoteAddr: &net.TCPAddr{IP: net.ParseIP("198.51.100.2"), Port: 40000},
+	}
+	outsidePeer := &mockConn{
+		localAddr:  &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+		remoteAddr: &net.TCPAddr{IP: net.ParseIP("198.51.100.2"), Port: 40000},
+	}
+	inside := &mockConn{
+		localAddr:  &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+		remoteAddr: &net.TCPAddr{IP: net.ParseIP("192.0.2.2"), Port: 40000},
+	}
+	loopback := &mockConn{
+		localAddr:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+		remoteAddr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000},
+	}
+
+	l := &subnetListener{
+		Listener: &mockListener{
+			conns: []*mockConn{outside, outsidePeer, inside, loopback},
+		},
+		subnets: []*net.IPNet{subnet},
+	}
+
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	assert.Same(t, inside, conn)
+	assert.True(t, outside.closed)
+	assert.True(t, outsidePeer.closed)
+
+	conn, err = l.Accept()
+	require.NoError(t, err)
+	assert.Same(t, loopback, conn)
+
+	_, err = l.Accept()
+	assert.Equal(t, io.EOF, err)
+}
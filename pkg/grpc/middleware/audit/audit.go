@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package audit provides grpc middleware which records API calls to the audit log.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+)
+
+// Record is a single entry of the audit log.
+type Record struct {
+	Time     time.Time       `json:"ts"`
+	Identity string          `json:"identity,omitempty"`
+	Roles    []string        `json:"roles"`
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Code     string          `json:"code"`
+	Error    string          `json:"error,omitempty"`
+	Duration string          `json:"duration"`
+}
+
+// Middleware writes an audit record for each audited API call.
+//
+// Middleware should be installed after the authz.Injector, so that the client identity and roles are known,
+// and before the authz.Authorizer, so that denied calls are recorded as well.
+type Middleware struct {
+	// Filter returns true if calls of the method should be recorded.
+	Filter func(fullMethod string) bool
+	// Writer receives records, one JSON object per line.
+	Writer io.Writer
+
+	mu sync.Mutex
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (m *Middleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !m.Filter(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.record(ctx, info.FullMethod, req, startTime, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+//
+// Arguments of the streaming calls are not recorded.
+func (m *Middleware) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !m.Filter(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		startTime := time.Now()
+
+		err := handler(srv, stream)
+
+		m.record(stream.Context(), info.FullMethod, nil, startTime, err)
+
+		return err
+	}
+}
+
+func (m *Middleware) record(ctx context.Context, method string, req interface{}, startTime time.Time, err error) {
+	record := Record{
+		Time:     startTime.UTC(),
+		Identity: authz.GetIdentity(ctx),
+		Roles:    authz.GetRoles(ctx).Strings(),
+		Method:   method,
+		Code:     status.Code(err).String(),
+		Duration: time.Since(startTime).String(),
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		record.Request = marshalRequest(msg)
+	}
+
+	b, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Writer.Write(append(b, '\n')) //nolint:errcheck
+}
+
+// marshalRequest returns the request with the binary fields (machine configuration, file contents, etc.) removed.
+func marshalRequest(req proto.Message) json.RawMessage {
+	req = proto.Clone(req)
+
+	redact(req.ProtoReflect())
+
+	b, err := protojson.Marshal(req)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Clear(fd)
+		case fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind:
+		case fd.IsList():
+			list := v.List()
+
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Kind() == protoreflect.BytesKind:
+			m.Clear(fd)
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				if fd.MapValue().Kind() == protoreflect.MessageKind {
+					redact(mv.Message())
+				}
+
+				return true
+			})
+		default:
+			redact(v.Message())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+
+	m := &audit.Middleware{
+		Filter: func(fullMethod string) bool {
+			return fullMethod == "/machine.MachineService/ApplyConfiguration"
+		},
+		Writer: &buf,
+	}
+
+	ctx := authz.ContextWithIdentity(context.Background(), "admin@example.com")
+	ctx = authz.ContextWithRoles(ctx, role.MakeSet(role.Admin))
+
+	interceptor := m.UnaryInterceptor()
+
+	req := &machine.ApplyConfigurationRequest{
+		Data:     []byte("machine:\n  token: secret\n"),
+		OnReboot: true,
+	}
+
+	_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/machine.MachineService/ApplyConfiguration"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.InvalidArgument, "invalid config")
+		})
+	require.Error(t, err)
+
+	_, err = interceptor(ctx, &machine.ApplyConfigurationRequest{}, &grpc.UnaryServerInfo{FullMethod: "/machine.MachineService/Version"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	require.NoError(t, err)
+
+	// data is not modified for the handler
+	assert.Equal(t, "machine:\n  token: secret\n", string(req.Data))
+
+	assert.NotContains(t, buf.String(), "secret")
+
+	var record audit.Record
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, "admin@example.com", record.Identity)
+	assert.Equal(t, []string{"os:admin"}, record.Roles)
+	assert.Equal(t, "/machine.MachineService/ApplyConfiguration", record.Method)
+	assert.JSONEq(t, `{"onReboot": true}`, string(record.Request))
+	assert.Equal(t, "InvalidArgument", record.Code)
+	assert.Equal(t, "rpc error: code = InvalidArgument desc = invalid config", record.Error)
+}
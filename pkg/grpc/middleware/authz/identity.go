@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// identityMDKey is used to store the client identity in gRPC metadata.
+const identityMDKey = "talos-identity"
+
+// identityCtxKey is used to store the client identity in the context.
+type identityCtxKey struct{}
+
+// GetIdentity returns the client identity stored in the context by the Injector interceptor.
+//
+// Identity is the common name of the client certificate, it is empty if the client is not known.
+func GetIdentity(ctx context.Context) string {
+	identity, _ := ctx.Value(identityCtxKey{}).(string) //nolint:errcheck
+
+	return identity
+}
+
+// ContextWithIdentity returns derived context with the client identity set.
+func ContextWithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityCtxKey{}, identity)
+}
+
+// SetIdentityMetadata sets given client identity in gRPC metadata.
+func SetIdentityMetadata(md metadata.MD, identity string) {
+	if identity == "" {
+		delete(md, identityMDKey)
+
+		return
+	}
+
+	md.Set(identityMDKey, identity)
+}
+
+// getIdentityFromMetadata returns the client identity extracted from gRPC metadata.
+func getIdentityFromMetadata(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(identityMDKey)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// peerCertificate returns the client certificate, if any.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.PeerCertificates[0]
+}
@@ -104,9 +104,36 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 	panic("unreachable")
 }
 
+// extractIdentity returns the common name of the user's certificate (in case of the first apid instance),
+// or the identity from gRPC metadata (in case of subsequent apid instances, machined, or user with impersonator role).
+//
+// Unlike roles, identity from gRPC metadata is trusted only from clients with impersonator role even if RBAC is disabled,
+// as it is used to attribute requests (e.g. for rate limiting).
+func (i *Injector) extractIdentity(ctx context.Context) string {
+	metadataIdentity, inMetadata := getIdentityFromMetadata(ctx)
+
+	if i.Mode == MetadataOnly {
+		return metadataIdentity
+	}
+
+	cert := peerCertificate(ctx)
+	if cert == nil {
+		return ""
+	}
+
+	if inMetadata {
+		if roles, _ := role.Parse(cert.Subject.Organization); roles.Includes(role.Impersonator) {
+			return metadataIdentity
+		}
+	}
+
+	return cert.Subject.CommonName
+}
+
 // UnaryInterceptor returns grpc UnaryServerInterceptor.
 func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = ContextWithIdentity(ctx, i.extractIdentity(ctx))
 		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
 
 		return handler(ctx, req)
@@ -117,6 +144,7 @@ func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
 func (i *Injector) StreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		ctx := stream.Context()
+		ctx = ContextWithIdentity(ctx, i.extractIdentity(ctx))
 		ctx = ContextWithRoles(ctx, i.extractRoles(ctx))
 
 		wrapped := grpc_middleware.WrapServerStream(stream)
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func TestInjectorMetadataOnly(t *testing.T) {
+	md := metadata.New(nil)
+	authz.SetMetadata(md, role.MakeSet(role.Reader))
+	authz.SetIdentityMetadata(md, "reader@example.com")
+
+	injector := &authz.Injector{
+		Mode: authz.MetadataOnly,
+	}
+
+	_, err := injector.UnaryInterceptor()(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			assert.Equal(t, role.MakeSet(role.Reader), authz.GetRoles(ctx))
+			assert.Equal(t, "reader@example.com", authz.GetIdentity(ctx))
+
+			return nil, nil
+		})
+	require.NoError(t, err)
+
+	// identity is not forwarded if it's not known
+	authz.SetIdentityMetadata(md, "")
+	assert.Empty(t, md.Get("talos-identity"))
+}
+
+func TestInjectorIdentity(t *testing.T) {
+	peerContext := func(md metadata.MD, cn string, orgs ...string) context.Context {
+		cert := &x509.Certificate{
+			Subject: pkix.Name{
+				CommonName:   cn,
+				Organization: orgs,
+			},
+		}
+
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{cert},
+				},
+			},
+		})
+
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	md := metadata.New(nil)
+	authz.SetIdentityMetadata(md, "admin@example.com")
+
+	for _, mode := range []authz.InjectorMode{authz.Disabled, authz.Enabled} {
+		injector := &authz.Injector{
+			Mode: mode,
+		}
+
+		for _, tt := range []struct {
+			ctx      context.Context
+			expected string
+		}{
+			{
+				ctx:      peerContext(md, "reader@example.com", string(role.Reader)),
+				expected: "reader@example.com",
+			},
+			{
+				ctx:      peerContext(md, "apid", string(role.Impersonator)),
+				expected: "admin@example.com",
+			},
+			{
+				ctx:      peerContext(metadata.New(nil), "apid", string(role.Impersonator)),
+				expected: "apid",
+			},
+		} {
+			tt := tt
+
+			_, err := injector.UnaryInterceptor()(tt.ctx, nil, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					assert.Equal(t, tt.expected, authz.GetIdentity(ctx))
+
+					return nil, nil
+				})
+			require.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+)
+
+func TestLimiterBuckets(t *testing.T) {
+	now := time.Now()
+
+	limits := map[string]Limit{
+		"/machine.MachineService/Stats": {Rate: 1, Burst: 1},
+	}
+
+	l := &Limiter{
+		Limit: func(fullMethod string) (Limit, bool) {
+			limit, ok := limits[fullMethod]
+
+			return limit, ok
+		},
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	call := func(identity, method string) error {
+		return l.allow(authz.ContextWithIdentity(context.Background(), identity), method)
+	}
+
+	// unlimited methods don't get buckets
+	for _, identity := range []string{"alice", "bob", "carol"} {
+		assert.NoError(t, call(identity, "/machine.MachineService/Version"))
+	}
+
+	assert.Empty(t, l.buckets)
+
+	assert.NoError(t, call("alice", "/machine.MachineService/Stats"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("alice", "/machine.MachineService/Stats")))
+	assert.NoError(t, call("bob", "/machine.MachineService/Stats"))
+	assert.Len(t, l.buckets, 2)
+
+	// changed limits are applied to the existing buckets
+	limits["/machine.MachineService/Stats"] = Limit{Rate: 100, Burst: 1}
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("alice", "/machine.MachineService/Stats")))
+
+	now = now.Add(20 * time.Millisecond)
+
+	assert.NoError(t, call("alice", "/machine.MachineService/Stats"))
+
+	// removed limits drop the buckets
+	delete(limits, "/machine.MachineService/Stats")
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, call("alice", "/machine.MachineService/Stats"))
+	}
+
+	assert.Len(t, l.buckets, 1)
+
+	// idle buckets are evicted once they are refilled
+	limits["/machine.MachineService/Stats"] = Limit{Rate: 1, Burst: 1}
+
+	now = now.Add(2 * sweepInterval)
+
+	assert.NoError(t, call("carol", "/machine.MachineService/Stats"))
+	assert.Len(t, l.buckets, 1)
+	assert.Contains(t, l.buckets, bucketKey{method: "/machine.MachineService/Stats", identity: "carol"})
+}
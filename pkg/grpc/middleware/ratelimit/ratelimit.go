@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ratelimit provides grpc middleware which limits the rate of API calls per method and client.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+)
+
+// Limit is a token bucket rate limit.
+type Limit struct {
+	// Rate is the number of calls per second allowed on average, zero means no limit.
+	Rate rate.Limit
+	// Burst is the maximum number of calls allowed at once.
+	Burst int
+}
+
+// refillPeriod returns the time it takes to refill the empty bucket.
+func (limit Limit) refillPeriod() time.Duration {
+	return time.Duration(float64(limit.Burst) / float64(limit.Rate) * float64(time.Second))
+}
+
+// sweepInterval is the interval between the checks for the idle buckets.
+const sweepInterval = time.Minute
+
+type bucketKey struct {
+	method   string
+	identity string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	limit    Limit
+	lastUsed time.Time
+}
+
+// Limiter rejects API calls over the rate limit with codes.ResourceExhausted.
+//
+// Each client (identified by authz.GetIdentity) gets a separate token bucket for each method,
+// so Limiter should be installed after the authz.Injector.
+type Limiter struct {
+	// Limit returns the rate limit for the method, methods without a limit are not limited.
+	//
+	// Limit is called on each API call, so that the changes to the limits are applied to the existing buckets.
+	Limit func(fullMethod string) (Limit, bool)
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+
+	// now is overridden in the tests.
+	now func() time.Time
+}
+
+func (l *Limiter) allow(ctx context.Context, method string) error {
+	limit, limited := l.Limit(method)
+	limited = limited && limit.Rate > 0
+
+	key := bucketKey{
+		method:   method,
+		identity: authz.GetIdentity(ctx),
+	}
+
+	now := time.Now()
+	if l.now != nil {
+		now = l.now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	if !limited {
+		// the limit might have been removed from the configuration
+		delete(l.buckets, key)
+
+		return nil
+	}
+
+	b, ok := l.buckets[key]
+
+	switch {
+	case !ok:
+		b = &bucket{
+			limiter: rate.NewLimiter(limit.Rate, limit.Burst),
+			limit:   limit,
+		}
+
+		if l.buckets == nil {
+			l.buckets = map[bucketKey]*bucket{}
+		}
+
+		l.buckets[key] = b
+	case b.limit != limit:
+		b.limiter.SetLimitAt(now, limit.Rate)
+		b.limiter.SetBurstAt(now, limit.Burst)
+		b.limit = limit
+	}
+
+	b.lastUsed = now
+
+	if !b.limiter.AllowN(now, 1) {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", method)
+	}
+
+	return nil
+}
+
+// sweep removes the buckets which were idle long enough to be refilled, as they are no different from the new ones.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastUsed) >= b.limit.refillPeriod() {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (l *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (l *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
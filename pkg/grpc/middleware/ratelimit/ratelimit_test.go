@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/ratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := &ratelimit.Limiter{
+		Limit: func(fullMethod string) (ratelimit.Limit, bool) {
+			if fullMethod == "/machine.MachineService/Stats" {
+				return ratelimit.Limit{Rate: 0.001, Burst: 2}, true
+			}
+
+			return ratelimit.Limit{}, false
+		},
+	}
+
+	interceptor := limiter.UnaryInterceptor()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	call := func(identity, method string) error {
+		_, err := interceptor(authz.ContextWithIdentity(context.Background(), identity), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+		return err
+	}
+
+	assert.NoError(t, call("alice", "/machine.MachineService/Stats"))
+	assert.NoError(t, call("alice", "/machine.MachineService/Stats"))
+
+	err := call("alice", "/machine.MachineService/Stats")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// other clients have their own limit
+	assert.NoError(t, call("bob", "/machine.MachineService/Stats"))
+
+	// other methods are not limited
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, call("alice", "/machine.MachineService/Version"))
+	}
+}
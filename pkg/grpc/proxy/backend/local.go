@@ -42,6 +42,7 @@ func (l *Local) GetConnection(ctx context.Context) (context.Context, *grpc.Clien
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	authz.SetIdentityMetadata(md, authz.GetIdentity(ctx))
 
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
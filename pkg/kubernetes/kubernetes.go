@@ -410,9 +410,27 @@ func (h *Client) Uncordon(ctx context.Context, name string, force bool) error {
 	return nil
 }
 
+// DrainOptions configures the node drain.
+type DrainOptions struct {
+	// Timeout is the maximum time to wait for the node to be drained, DrainTimeout is used if not set.
+	Timeout time.Duration
+	// Force enables eviction of the pods not managed by a controller.
+	Force bool
+}
+
 // Drain evicts all pods on a given node.
 func (h *Client) Drain(ctx context.Context, node string) error {
-	ctx, cancel := context.WithTimeout(ctx, DrainTimeout)
+	return h.DrainWithOptions(ctx, node, DrainOptions{})
+}
+
+// DrainWithOptions evicts all pods on a given node with the specified options.
+func (h *Client) DrainWithOptions(ctx context.Context, node string, drainOpts DrainOptions) error {
+	timeout := drainOpts.Timeout
+	if timeout == 0 {
+		timeout = DrainTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	opts := metav1.ListOptions{
@@ -440,13 +458,13 @@ func (h *Client) Drain(ctx context.Context, node string) error {
 
 			controllerRef := metav1.GetControllerOf(&p)
 
-			if controllerRef == nil {
+			if controllerRef == nil && !drainOpts.Force {
 				log.Printf("skipping unmanaged pod %s/%s\n", p.GetNamespace(), p.GetName())
 
 				return nil
 			}
 
-			if controllerRef.Kind == appsv1.SchemeGroupVersion.WithKind("DaemonSet").Kind {
+			if controllerRef != nil && controllerRef.Kind == appsv1.SchemeGroupVersion.WithKind("DaemonSet").Kind {
 				log.Printf("skipping DaemonSet pod %s/%s\n", p.GetNamespace(), p.GetName())
 
 				return nil
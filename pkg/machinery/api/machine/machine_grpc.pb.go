@@ -28,8 +28,23 @@ const _ = grpc.SupportPackageIsVersion7
 type MachineServiceClient interface {
 	ApplyConfiguration(ctx context.Context, in *ApplyConfigurationRequest, opts ...grpc.CallOption) (*ApplyConfigurationResponse, error)
 	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	// Config returns the machine configuration the node is running as YAML.
+	//
+	// Secrets are redacted if redacted is set, unredacted config requires
+	// the admin role.
+	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// ConfigDiff compares the machine configuration with the configuration the node is running.
+	//
+	// If no configuration is given, the configuration staged to be applied on reboot is compared.
+	// Each change is classified as applied immediately or requiring a reboot.
+	ConfigDiff(ctx context.Context, in *ConfigDiffRequest, opts ...grpc.CallOption) (*ConfigDiffResponse, error)
 	Containers(ctx context.Context, in *ContainersRequest, opts ...grpc.CallOption) (*ContainersResponse, error)
 	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (MachineService_CopyClient, error)
+	// ArchiveOut streams a tar archive of a directory for support bundles.
+	//
+	// Only directories under the prefixes allowed for ReadFile can be archived,
+	// files which might contain secrets are skipped. Archive size and time to produce it are limited.
+	ArchiveOut(ctx context.Context, in *ArchiveOutRequest, opts ...grpc.CallOption) (MachineService_ArchiveOutClient, error)
 	CPUInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CPUInfoResponse, error)
 	DiskStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiskStatsResponse, error)
 	Dmesg(ctx context.Context, in *DmesgRequest, opts ...grpc.CallOption) (MachineService_DmesgClient, error)
@@ -48,9 +63,20 @@ type MachineServiceClient interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(ctx context.Context, in *EtcdSnapshotRequest, opts ...grpc.CallOption) (MachineService_EtcdSnapshotClient, error)
+	// EtcdDefragment method defragments the backend database of the local etcd member.
+	//
+	// Defragmentation blocks the member while it runs, so it is refused on the etcd leader
+	// and only one member is defragmented at a time.
+	EtcdDefragment(ctx context.Context, in *EtcdDefragmentRequest, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error)
+	// EtcdCompact method compacts the etcd key-value history keeping the most recent revisions.
+	EtcdCompact(ctx context.Context, in *EtcdCompactRequest, opts ...grpc.CallOption) (*EtcdCompactResponse, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
+	// List lists files under the root path.
+	//
+	// Callers without the admin role can only list the paths allowed for ReadFile,
+	// directories which might contain secrets are skipped.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MachineService_ListClient, error)
 	DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (MachineService_DiskUsageClient, error)
 	LoadAvg(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoadAvgResponse, error)
@@ -58,12 +84,30 @@ type MachineServiceClient interface {
 	Memory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MemoryResponse, error)
 	Mounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MountsResponse, error)
 	NetworkDeviceStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkDeviceStatsResponse, error)
+	// Pressure returns pressure stall information (PSI) for CPU, memory and IO.
+	Pressure(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PressureResponse, error)
 	Processes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessesResponse, error)
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadClient, error)
+	// ReadFile streams the contents of a file for debugging.
+	//
+	// Unlike Read, only files under the allowed prefixes can be read,
+	// and files which look like secrets (keys, kubeconfigs, machine config) are rejected.
+	ReadFile(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadFileClient, error)
 	Reboot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebootResponse, error)
+	// ReconcileNow queues an immediate reconcile of the specified controller
+	// in the controller runtime.
+	ReconcileNow(ctx context.Context, in *ReconcileNowRequest, opts ...grpc.CallOption) (*ReconcileNowResponse, error)
 	Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*RestartResponse, error)
 	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	// Cordon method marks the Kubernetes node of the machine as unschedulable.
+	Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*CordonResponse, error)
+	// Drain method evicts the pods from the Kubernetes node of the machine.
+	//
+	// Node should be cordoned first, otherwise evicted pods might be scheduled back to the node.
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	// Uncordon method marks the Kubernetes node of the machine as schedulable.
+	Uncordon(ctx context.Context, in *UncordonRequest, opts ...grpc.CallOption) (*UncordonResponse, error)
 	// Deprecated: Do not use.
 	RemoveBootkubeInitializedKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RemoveBootkubeInitializedKeyResponse, error)
 	ServiceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error)
@@ -72,6 +116,12 @@ type MachineServiceClient interface {
 	ServiceStop(ctx context.Context, in *ServiceStopRequest, opts ...grpc.CallOption) (*ServiceStopResponse, error)
 	Shutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	// SupportBundle streams a .tar.gz archive with node diagnostics: machine configuration,
+	// kernel log, service logs, services, mounts, processes, network and controller status.
+	//
+	// Secrets (private keys, tokens, passwords, machine configuration secrets and
+	// patterns from .machine.redactPatterns) are redacted.
+	SupportBundle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_SupportBundleClient, error)
 	SystemStat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SystemStatResponse, error)
 	Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeResponse, error)
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
@@ -105,6 +155,24 @@ func (c *machineServiceClient) Bootstrap(ctx context.Context, in *BootstrapReque
 	return out, nil
 }
 
+func (c *machineServiceClient) Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ConfigDiff(ctx context.Context, in *ConfigDiffRequest, opts ...grpc.CallOption) (*ConfigDiffResponse, error) {
+	out := new(ConfigDiffResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/ConfigDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Containers(ctx context.Context, in *ContainersRequest, opts ...grpc.CallOption) (*ContainersResponse, error) {
 	out := new(ContainersResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Containers", in, out, opts...)
@@ -146,6 +214,38 @@ func (x *machineServiceCopyClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) ArchiveOut(ctx context.Context, in *ArchiveOutRequest, opts ...grpc.CallOption) (MachineService_ArchiveOutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[1], "/machine.MachineService/ArchiveOut", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceArchiveOutClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ArchiveOutClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServiceArchiveOutClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceArchiveOutClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *machineServiceClient) CPUInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CPUInfoResponse, error) {
 	out := new(CPUInfoResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/CPUInfo", in, out, opts...)
@@ -165,7 +265,7 @@ func (c *machineServiceClient) DiskStats(ctx context.Context, in *emptypb.Empty,
 }
 
 func (c *machineServiceClient) Dmesg(ctx context.Context, in *DmesgRequest, opts ...grpc.CallOption) (MachineService_DmesgClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[1], "/machine.MachineService/Dmesg", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[2], "/machine.MachineService/Dmesg", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +297,7 @@ func (x *machineServiceDmesgClient) Recv() (*common.Data, error) {
 }
 
 func (c *machineServiceClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (MachineService_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[2], "/machine.MachineService/Events", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[3], "/machine.MachineService/Events", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +365,7 @@ func (c *machineServiceClient) EtcdForfeitLeadership(ctx context.Context, in *Et
 }
 
 func (c *machineServiceClient) EtcdRecover(ctx context.Context, opts ...grpc.CallOption) (MachineService_EtcdRecoverClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[3], "/machine.MachineService/EtcdRecover", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[4], "/machine.MachineService/EtcdRecover", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -299,7 +399,7 @@ func (x *machineServiceEtcdRecoverClient) CloseAndRecv() (*EtcdRecoverResponse,
 }
 
 func (c *machineServiceClient) EtcdSnapshot(ctx context.Context, in *EtcdSnapshotRequest, opts ...grpc.CallOption) (MachineService_EtcdSnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[4], "/machine.MachineService/EtcdSnapshot", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[5], "/machine.MachineService/EtcdSnapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -330,6 +430,24 @@ func (x *machineServiceEtcdSnapshotClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) EtcdDefragment(ctx context.Context, in *EtcdDefragmentRequest, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error) {
+	out := new(EtcdDefragmentResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdDefragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdCompact(ctx context.Context, in *EtcdCompactRequest, opts ...grpc.CallOption) (*EtcdCompactResponse, error) {
+	out := new(EtcdCompactResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdCompact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error) {
 	out := new(GenerateConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/GenerateConfiguration", in, out, opts...)
@@ -349,7 +467,7 @@ func (c *machineServiceClient) Hostname(ctx context.Context, in *emptypb.Empty,
 }
 
 func (c *machineServiceClient) Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[5], "/machine.MachineService/Kubeconfig", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[6], "/machine.MachineService/Kubeconfig", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -381,7 +499,7 @@ func (x *machineServiceKubeconfigClient) Recv() (*common.Data, error) {
 }
 
 func (c *machineServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MachineService_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[6], "/machine.MachineService/List", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[7], "/machine.MachineService/List", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +531,7 @@ func (x *machineServiceListClient) Recv() (*FileInfo, error) {
 }
 
 func (c *machineServiceClient) DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (MachineService_DiskUsageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[7], "/machine.MachineService/DiskUsage", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[8], "/machine.MachineService/DiskUsage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +572,7 @@ func (c *machineServiceClient) LoadAvg(ctx context.Context, in *emptypb.Empty, o
 }
 
 func (c *machineServiceClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (MachineService_LogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[8], "/machine.MachineService/Logs", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[9], "/machine.MachineService/Logs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -512,6 +630,15 @@ func (c *machineServiceClient) NetworkDeviceStats(ctx context.Context, in *empty
 	return out, nil
 }
 
+func (c *machineServiceClient) Pressure(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PressureResponse, error) {
+	out := new(PressureResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Pressure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Processes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessesResponse, error) {
 	out := new(ProcessesResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Processes", in, out, opts...)
@@ -522,7 +649,7 @@ func (c *machineServiceClient) Processes(ctx context.Context, in *emptypb.Empty,
 }
 
 func (c *machineServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[9], "/machine.MachineService/Read", opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[10], "/machine.MachineService/Read", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -553,6 +680,38 @@ func (x *machineServiceReadClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) ReadFile(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[11], "/machine.MachineService/ReadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceReadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ReadFileClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServiceReadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceReadFileClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *machineServiceClient) Reboot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebootResponse, error) {
 	out := new(RebootResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Reboot", in, out, opts...)
@@ -562,6 +721,15 @@ func (c *machineServiceClient) Reboot(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *machineServiceClient) ReconcileNow(ctx context.Context, in *ReconcileNowRequest, opts ...grpc.CallOption) (*ReconcileNowResponse, error) {
+	out := new(ReconcileNowResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/ReconcileNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*RestartResponse, error) {
 	out := new(RestartResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Restart", in, out, opts...)
@@ -589,6 +757,33 @@ func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*CordonResponse, error) {
+	out := new(CordonResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Cordon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) Uncordon(ctx context.Context, in *UncordonRequest, opts ...grpc.CallOption) (*UncordonResponse, error) {
+	out := new(UncordonResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Uncordon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *machineServiceClient) RemoveBootkubeInitializedKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RemoveBootkubeInitializedKeyResponse, error) {
 	out := new(RemoveBootkubeInitializedKeyResponse)
@@ -653,6 +848,38 @@ func (c *machineServiceClient) Stats(ctx context.Context, in *StatsRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) SupportBundle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_SupportBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], "/machine.MachineService/SupportBundle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceSupportBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_SupportBundleClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServiceSupportBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceSupportBundleClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *machineServiceClient) SystemStat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SystemStatResponse, error) {
 	out := new(SystemStatResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/SystemStat", in, out, opts...)
@@ -695,8 +922,23 @@ func (c *machineServiceClient) GenerateClientConfiguration(ctx context.Context,
 type MachineServiceServer interface {
 	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
 	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	// Config returns the machine configuration the node is running as YAML.
+	//
+	// Secrets are redacted if redacted is set, unredacted config requires
+	// the admin role.
+	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	// ConfigDiff compares the machine configuration with the configuration the node is running.
+	//
+	// If no configuration is given, the configuration staged to be applied on reboot is compared.
+	// Each change is classified as applied immediately or requiring a reboot.
+	ConfigDiff(context.Context, *ConfigDiffRequest) (*ConfigDiffResponse, error)
 	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
 	Copy(*CopyRequest, MachineService_CopyServer) error
+	// ArchiveOut streams a tar archive of a directory for support bundles.
+	//
+	// Only directories under the prefixes allowed for ReadFile can be archived,
+	// files which might contain secrets are skipped. Archive size and time to produce it are limited.
+	ArchiveOut(*ArchiveOutRequest, MachineService_ArchiveOutServer) error
 	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
 	DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error)
 	Dmesg(*DmesgRequest, MachineService_DmesgServer) error
@@ -715,9 +957,20 @@ type MachineServiceServer interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
+	// EtcdDefragment method defragments the backend database of the local etcd member.
+	//
+	// Defragmentation blocks the member while it runs, so it is refused on the etcd leader
+	// and only one member is defragmented at a time.
+	EtcdDefragment(context.Context, *EtcdDefragmentRequest) (*EtcdDefragmentResponse, error)
+	// EtcdCompact method compacts the etcd key-value history keeping the most recent revisions.
+	EtcdCompact(context.Context, *EtcdCompactRequest) (*EtcdCompactResponse, error)
 	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
 	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
 	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
+	// List lists files under the root path.
+	//
+	// Callers without the admin role can only list the paths allowed for ReadFile,
+	// directories which might contain secrets are skipped.
 	List(*ListRequest, MachineService_ListServer) error
 	DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error
 	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
@@ -725,12 +978,30 @@ type MachineServiceServer interface {
 	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
 	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
 	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
+	// Pressure returns pressure stall information (PSI) for CPU, memory and IO.
+	Pressure(context.Context, *emptypb.Empty) (*PressureResponse, error)
 	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
 	Read(*ReadRequest, MachineService_ReadServer) error
+	// ReadFile streams the contents of a file for debugging.
+	//
+	// Unlike Read, only files under the allowed prefixes can be read,
+	// and files which look like secrets (keys, kubeconfigs, machine config) are rejected.
+	ReadFile(*ReadRequest, MachineService_ReadFileServer) error
 	Reboot(context.Context, *emptypb.Empty) (*RebootResponse, error)
+	// ReconcileNow queues an immediate reconcile of the specified controller
+	// in the controller runtime.
+	ReconcileNow(context.Context, *ReconcileNowRequest) (*ReconcileNowResponse, error)
 	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
 	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
 	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	// Cordon method marks the Kubernetes node of the machine as unschedulable.
+	Cordon(context.Context, *CordonRequest) (*CordonResponse, error)
+	// Drain method evicts the pods from the Kubernetes node of the machine.
+	//
+	// Node should be cordoned first, otherwise evicted pods might be scheduled back to the node.
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	// Uncordon method marks the Kubernetes node of the machine as schedulable.
+	Uncordon(context.Context, *UncordonRequest) (*UncordonResponse, error)
 	// Deprecated: Do not use.
 	RemoveBootkubeInitializedKey(context.Context, *emptypb.Empty) (*RemoveBootkubeInitializedKeyResponse, error)
 	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
@@ -739,6 +1010,12 @@ type MachineServiceServer interface {
 	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
 	Shutdown(context.Context, *emptypb.Empty) (*ShutdownResponse, error)
 	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	// SupportBundle streams a .tar.gz archive with node diagnostics: machine configuration,
+	// kernel log, service logs, services, mounts, processes, network and controller status.
+	//
+	// Secrets (private keys, tokens, passwords, machine configuration secrets and
+	// patterns from .machine.redactPatterns) are redacted.
+	SupportBundle(*emptypb.Empty, MachineService_SupportBundleServer) error
 	SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error)
 	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
 	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
@@ -758,6 +1035,14 @@ func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRe
 	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
 }
 
+func (UnimplementedMachineServiceServer) Config(context.Context, *ConfigRequest) (*ConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+
+func (UnimplementedMachineServiceServer) ConfigDiff(context.Context, *ConfigDiffRequest) (*ConfigDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigDiff not implemented")
+}
+
 func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
 }
@@ -766,6 +1051,10 @@ func (UnimplementedMachineServiceServer) Copy(*CopyRequest, MachineService_CopyS
 	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
 }
 
+func (UnimplementedMachineServiceServer) ArchiveOut(*ArchiveOutRequest, MachineService_ArchiveOutServer) error {
+	return status.Errorf(codes.Unimplemented, "method ArchiveOut not implemented")
+}
+
 func (UnimplementedMachineServiceServer) CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CPUInfo not implemented")
 }
@@ -806,6 +1095,14 @@ func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, Mach
 	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
 }
 
+func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *EtcdDefragmentRequest) (*EtcdDefragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+}
+
+func (UnimplementedMachineServiceServer) EtcdCompact(context.Context, *EtcdCompactRequest) (*EtcdCompactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdCompact not implemented")
+}
+
 func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
 }
@@ -846,6 +1143,10 @@ func (UnimplementedMachineServiceServer) NetworkDeviceStats(context.Context, *em
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkDeviceStats not implemented")
 }
 
+func (UnimplementedMachineServiceServer) Pressure(context.Context, *emptypb.Empty) (*PressureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pressure not implemented")
+}
+
 func (UnimplementedMachineServiceServer) Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Processes not implemented")
 }
@@ -854,10 +1155,18 @@ func (UnimplementedMachineServiceServer) Read(*ReadRequest, MachineService_ReadS
 	return status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
 
+func (UnimplementedMachineServiceServer) ReadFile(*ReadRequest, MachineService_ReadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+
 func (UnimplementedMachineServiceServer) Reboot(context.Context, *emptypb.Empty) (*RebootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
 }
 
+func (UnimplementedMachineServiceServer) ReconcileNow(context.Context, *ReconcileNowRequest) (*ReconcileNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileNow not implemented")
+}
+
 func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartRequest) (*RestartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
 }
@@ -870,6 +1179,18 @@ func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
 
+func (UnimplementedMachineServiceServer) Cordon(context.Context, *CordonRequest) (*CordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cordon not implemented")
+}
+
+func (UnimplementedMachineServiceServer) Drain(context.Context, *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+
+func (UnimplementedMachineServiceServer) Uncordon(context.Context, *UncordonRequest) (*UncordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uncordon not implemented")
+}
+
 func (UnimplementedMachineServiceServer) RemoveBootkubeInitializedKey(context.Context, *emptypb.Empty) (*RemoveBootkubeInitializedKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBootkubeInitializedKey not implemented")
 }
@@ -898,6 +1219,10 @@ func (UnimplementedMachineServiceServer) Stats(context.Context, *StatsRequest) (
 	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
 }
 
+func (UnimplementedMachineServiceServer) SupportBundle(*emptypb.Empty, MachineService_SupportBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method SupportBundle not implemented")
+}
+
 func (UnimplementedMachineServiceServer) SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStat not implemented")
 }
@@ -962,6 +1287,42 @@ func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Config(ctx, req.(*ConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ConfigDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ConfigDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/ConfigDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ConfigDiff(ctx, req.(*ConfigDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainersRequest)
 	if err := dec(in); err != nil {
@@ -1001,6 +1362,27 @@ func (x *machineServiceCopyServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_ArchiveOut_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveOutRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ArchiveOut(m, &machineServiceArchiveOutServer{stream})
+}
+
+type MachineService_ArchiveOutServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceArchiveOutServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceArchiveOutServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MachineService_CPUInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1198,6 +1580,42 @@ func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdDefragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdDefragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*EtcdDefragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdCompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdCompact(ctx, req.(*EtcdCompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -1390,6 +1808,24 @@ func _MachineService_NetworkDeviceStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_Pressure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Pressure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Pressure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Pressure(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Processes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1429,6 +1865,27 @@ func (x *machineServiceReadServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ReadFile(m, &machineServiceReadFileServer{stream})
+}
+
+type MachineService_ReadFileServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceReadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceReadFileServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1447,6 +1904,24 @@ func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ReconcileNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ReconcileNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/ReconcileNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ReconcileNow(ctx, req.(*ReconcileNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RestartRequest)
 	if err := dec(in); err != nil {
@@ -1501,6 +1976,60 @@ func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_Cordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Cordon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Cordon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Cordon(ctx, req.(*CordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Uncordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Uncordon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Uncordon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Uncordon(ctx, req.(*UncordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_RemoveBootkubeInitializedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1627,6 +2156,27 @@ func _MachineService_Stats_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_SupportBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).SupportBundle(m, &machineServiceSupportBundleServer{stream})
+}
+
+type MachineService_SupportBundleServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceSupportBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceSupportBundleServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MachineService_SystemStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1714,6 +2264,14 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Bootstrap",
 			Handler:    _MachineService_Bootstrap_Handler,
 		},
+		{
+			MethodName: "Config",
+			Handler:    _MachineService_Config_Handler,
+		},
+		{
+			MethodName: "ConfigDiff",
+			Handler:    _MachineService_ConfigDiff_Handler,
+		},
 		{
 			MethodName: "Containers",
 			Handler:    _MachineService_Containers_Handler,
@@ -1742,6 +2300,14 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EtcdForfeitLeadership",
 			Handler:    _MachineService_EtcdForfeitLeadership_Handler,
 		},
+		{
+			MethodName: "EtcdDefragment",
+			Handler:    _MachineService_EtcdDefragment_Handler,
+		},
+		{
+			MethodName: "EtcdCompact",
+			Handler:    _MachineService_EtcdCompact_Handler,
+		},
 		{
 			MethodName: "GenerateConfiguration",
 			Handler:    _MachineService_GenerateConfiguration_Handler,
@@ -1766,6 +2332,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NetworkDeviceStats",
 			Handler:    _MachineService_NetworkDeviceStats_Handler,
 		},
+		{
+			MethodName: "Pressure",
+			Handler:    _MachineService_Pressure_Handler,
+		},
 		{
 			MethodName: "Processes",
 			Handler:    _MachineService_Processes_Handler,
@@ -1774,6 +2344,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reboot",
 			Handler:    _MachineService_Reboot_Handler,
 		},
+		{
+			MethodName: "ReconcileNow",
+			Handler:    _MachineService_ReconcileNow_Handler,
+		},
 		{
 			MethodName: "Restart",
 			Handler:    _MachineService_Restart_Handler,
@@ -1786,6 +2360,18 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _MachineService_Reset_Handler,
 		},
+		{
+			MethodName: "Cordon",
+			Handler:    _MachineService_Cordon_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _MachineService_Drain_Handler,
+		},
+		{
+			MethodName: "Uncordon",
+			Handler:    _MachineService_Uncordon_Handler,
+		},
 		{
 			MethodName: "RemoveBootkubeInitializedKey",
 			Handler:    _MachineService_RemoveBootkubeInitializedKey_Handler,
@@ -1837,6 +2423,11 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_Copy_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ArchiveOut",
+			Handler:       _MachineService_ArchiveOut_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Dmesg",
 			Handler:       _MachineService_Dmesg_Handler,
@@ -1882,6 +2473,16 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReadFile",
+			Handler:       _MachineService_ReadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SupportBundle",
+			Handler:       _MachineService_SupportBundle_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
@@ -452,6 +452,39 @@ func (c *Client) Containers(ctx context.Context, namespace string, driver common
 	return
 }
 
+// ContainersOptions are the options for ContainersPage.
+type ContainersOptions struct {
+	// Filter returns only the containers with the ID, pod ID, name or image containing it.
+	Filter string
+	// Limit is the maximum number of containers in the page.
+	Limit uint32
+	// ContinueToken is returned with the previous page.
+	ContinueToken string
+}
+
+// ContainersPage lists the containers page by page.
+//
+// Continue token is only valid for the node which returned it.
+func (c *Client) ContainersPage(ctx context.Context, namespace string, driver common.ContainerDriver, opts ContainersOptions, callOptions ...grpc.CallOption) (resp *machineapi.ContainersResponse, err error) {
+	resp, err = c.MachineClient.Containers(
+		ctx,
+		&machineapi.ContainersRequest{
+			Namespace:     namespace,
+			Driver:        driver,
+			Filter:        opts.Filter,
+			Limit:         opts.Limit,
+			ContinueToken: opts.ContinueToken,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ContainersResponse) //nolint:errcheck
+
+	return
+}
+
 // Restart implements the proto.MachineServiceClient interface.
 func (c *Client) Restart(ctx context.Context, namespace string, driver common.ContainerDriver, id string, callOptions ...grpc.CallOption) (err error) {
 	resp, err := c.MachineClient.Restart(ctx, &machineapi.RestartRequest{
@@ -489,6 +522,39 @@ func (c *Client) ResetGeneric(ctx context.Context, req *machineapi.ResetRequest)
 	return
 }
 
+// Cordon marks the Kubernetes node of the machine as unschedulable.
+func (c *Client) Cordon(ctx context.Context, req *machineapi.CordonRequest, callOptions ...grpc.CallOption) (*machineapi.CordonResponse, error) {
+	resp, err := c.MachineClient.Cordon(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.CordonResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// Drain evicts the pods from the Kubernetes node of the machine.
+func (c *Client) Drain(ctx context.Context, req *machineapi.DrainRequest, callOptions ...grpc.CallOption) (*machineapi.DrainResponse, error) {
+	resp, err := c.MachineClient.Drain(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.DrainResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// Uncordon marks the Kubernetes node of the machine as schedulable.
+func (c *Client) Uncordon(ctx context.Context, req *machineapi.UncordonRequest, callOptions ...grpc.CallOption) (*machineapi.UncordonResponse, error) {
+	resp, err := c.MachineClient.Uncordon(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UncordonResponse) //nolint:errcheck
+
+	return resp, err
+}
+
 // Reboot implements the proto.MachineServiceClient interface.
 func (c *Client) Reboot(ctx context.Context) (err error) {
 	resp, err := c.MachineClient.Reboot(ctx, &emptypb.Empty{})
@@ -511,6 +577,19 @@ func (c *Client) Rollback(ctx context.Context) (err error) {
 	return
 }
 
+// ReconcileNow implements the proto.MachineServiceClient interface.
+func (c *Client) ReconcileNow(ctx context.Context, controllerName string) (err error) {
+	resp, err := c.MachineClient.ReconcileNow(ctx, &machineapi.ReconcileNowRequest{
+		Controller: controllerName,
+	})
+
+	if err == nil {
+		_, err = FilterMessages(resp, err)
+	}
+
+	return
+}
+
 // Bootstrap implements the proto.MachineServiceClient interface.
 func (c *Client) Bootstrap(ctx context.Context, req *machineapi.BootstrapRequest) (err error) {
 	resp, err := c.MachineClient.Bootstrap(ctx, req)
@@ -522,6 +601,44 @@ func (c *Client) Bootstrap(ctx context.Context, req *machineapi.BootstrapRequest
 	return
 }
 
+// Config returns the machine configuration the node is running.
+//
+// Secrets are redacted if redacted is true.
+func (c *Client) Config(ctx context.Context, redacted bool, callOptions ...grpc.CallOption) (resp *machineapi.ConfigResponse, err error) {
+	resp, err = c.MachineClient.Config(
+		ctx,
+		&machineapi.ConfigRequest{
+			Redacted: redacted,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ConfigResponse) //nolint:errcheck
+
+	return
+}
+
+// ConfigDiff compares the machine configuration with the configuration the node is running.
+//
+// If data is empty, the configuration staged to be applied on reboot is compared.
+func (c *Client) ConfigDiff(ctx context.Context, data []byte, callOptions ...grpc.CallOption) (resp *machineapi.ConfigDiffResponse, err error) {
+	resp, err = c.MachineClient.ConfigDiff(
+		ctx,
+		&machineapi.ConfigDiffRequest{
+			Data: data,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ConfigDiffResponse) //nolint:errcheck
+
+	return
+}
+
 // Shutdown implements the proto.MachineServiceClient interface.
 func (c *Client) Shutdown(ctx context.Context) (err error) {
 	resp, err := c.MachineClient.Shutdown(ctx, &emptypb.Empty{})
@@ -629,6 +746,21 @@ func (c *Client) Memory(ctx context.Context, callOptions ...grpc.CallOption) (re
 	return
 }
 
+// Pressure implements the proto.MachineServiceClient interface.
+func (c *Client) Pressure(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.PressureResponse, err error) {
+	resp, err = c.MachineClient.Pressure(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.PressureResponse) //nolint:errcheck
+
+	return
+}
+
 // Mounts implements the proto.MachineServiceClient interface.
 func (c *Client) Mounts(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MountsResponse, err error) {
 	resp, err = c.MachineClient.Mounts(
@@ -666,6 +798,29 @@ func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, <-ch
 	return ReadStream(stream)
 }
 
+// ArchiveOut streams a tar archive of the directory, skipping files which might contain secrets.
+func (c *Client) ArchiveOut(ctx context.Context, rootPath string, gzip bool) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.ArchiveOut(ctx, &machineapi.ArchiveOutRequest{
+		RootPath: rootPath,
+		Gzip:     gzip,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// SupportBundle collects diagnostic information from the node as a .tar.gz archive.
+func (c *Client) SupportBundle(ctx context.Context) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.SupportBundle(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
 // Upgrade initiates a Talos upgrade ... and implements the proto.MachineServiceClient
 // interface.
 func (c *Client) Upgrade(ctx context.Context, image string, preserve, stage, force bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
@@ -834,6 +989,16 @@ func (c *Client) Read(ctx context.Context, path string) (io.ReadCloser, <-chan e
 	return ReadStream(stream)
 }
 
+// ReadFile reads a file from the allowed locations, rejecting files which look like secrets.
+func (c *Client) ReadFile(ctx context.Context, path string) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.ReadFile(ctx, &machineapi.ReadRequest{Path: path})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
 // ClusterHealthCheck runs a Talos cluster health check.
 func (c *Client) ClusterHealthCheck(ctx context.Context, waitTimeout time.Duration, clusterInfo *clusterapi.ClusterInfo) (clusterapi.ClusterService_HealthCheckClient, error) {
 	return c.ClusterClient.HealthCheck(ctx, &clusterapi.HealthCheckRequest{
@@ -875,6 +1040,28 @@ func (c *Client) EtcdForfeitLeadership(ctx context.Context, req *machineapi.Etcd
 	return resp, err
 }
 
+// EtcdDefragment defragments the backend database of the etcd member running on the node.
+func (c *Client) EtcdDefragment(ctx context.Context, req *machineapi.EtcdDefragmentRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdDefragmentResponse, error) {
+	resp, err := c.MachineClient.EtcdDefragment(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdDefragmentResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdCompact compacts the etcd key-value history keeping the most recent revisions.
+func (c *Client) EtcdCompact(ctx context.Context, req *machineapi.EtcdCompactRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdCompactResponse, error) {
+	resp, err := c.MachineClient.EtcdCompact(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdCompactResponse) //nolint:errcheck
+
+	return resp, err
+}
+
 // EtcdMemberList lists etcd members of the cluster.
 func (c *Client) EtcdMemberList(ctx context.Context, req *machineapi.EtcdMemberListRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdMemberListResponse, error) {
 	resp, err := c.MachineClient.EtcdMemberList(ctx, req, callOptions...)
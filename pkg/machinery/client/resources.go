@@ -25,6 +25,8 @@ type ResourceResponse struct {
 	Metadata   *common.Metadata
 	Definition resource.Resource
 	Resource   resource.Resource
+	// ContinueToken is set by paged List if there are more resources to fetch.
+	ContinueToken string
 }
 
 // WatchResponse is a parsed resource watch response.
@@ -95,6 +97,7 @@ func (client *ResourceListClient) Recv() (ResourceResponse, error) {
 	}
 
 	resourceResp.Metadata = msg.GetMetadata()
+	resourceResp.ContinueToken = msg.GetContinueToken()
 
 	if msg.GetDefinition() != nil {
 		var e error
@@ -129,6 +132,33 @@ func (c *ResourcesClient) List(ctx context.Context, resourceNamespace, resourceT
 	}, err
 }
 
+// ListOptions configures paged List.
+type ListOptions struct {
+	// Limit is the maximum number of resources in the page.
+	Limit uint32
+	// ContinueToken is returned with the previous page.
+	ContinueToken string
+	// Fields is the list of top-level spec fields to return.
+	Fields []string
+}
+
+// ListPage lists resources by kind page by page.
+//
+// Continue token is only valid for the node which returned it.
+func (c *ResourcesClient) ListPage(ctx context.Context, resourceNamespace, resourceType string, opts ListOptions, callOptions ...grpc.CallOption) (*ResourceListClient, error) {
+	client, err := c.client.List(ctx, &resourceapi.ListRequest{
+		Namespace:     resourceNamespace,
+		Type:          resourceType,
+		Limit:         opts.Limit,
+		ContinueToken: opts.ContinueToken,
+		Fields:        opts.Fields,
+	}, callOptions...)
+
+	return &ResourceListClient{
+		grpcClient: client,
+	}, err
+}
+
 // ResourceWatchClient wraps gRPC watch client.
 type ResourceWatchClient struct {
 	grpcClient resourceapi.ResourceService_WatchClient
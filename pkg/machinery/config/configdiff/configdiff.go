@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configdiff compares Talos machine configurations.
+package configdiff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+)
+
+// Op is the kind of the change.
+type Op int
+
+// Op values.
+const (
+	OpAdd Op = iota
+	OpRemove
+	OpModify
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpAdd:
+		return "add"
+	case OpRemove:
+		return "remove"
+	case OpModify:
+		return "modify"
+	default:
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+}
+
+// ImmediatePaths are the configuration paths which can be changed without a reboot.
+//
+// Changes to these paths are picked up by the controllers and services watching the configuration,
+// all other changes are only applied by the boot sequence.
+// The same list is used by the runtime to check whether the config can be applied in the immediate mode.
+var ImmediatePaths = []string{
+	"cluster",
+	"debug",
+	"machine.time",
+	"machine.network",
+	"machine.certSANs",
+}
+
+// Change describes a single changed configuration field.
+type Change struct {
+	// Path is the dot-separated path of the field, e.g. machine.network.hostname.
+	Path           string
+	Op             Op
+	RebootRequired bool
+}
+
+// Diff returns the changes required to turn current configuration into the next one.
+//
+// Maps are compared key by key, lists are compared as a whole.
+func Diff(current, next config.Provider) ([]Change, error) {
+	currentTree, err := toTree(current)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding current config: %w", err)
+	}
+
+	nextTree, err := toTree(next)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding new config: %w", err)
+	}
+
+	var changes []Change
+
+	diff(nil, currentTree, nextTree, &changes)
+
+	return changes, nil
+}
+
+// RebootRequired returns true if any of the changes requires a reboot.
+func RebootRequired(changes []Change) bool {
+	for _, change := range changes {
+		if change.RebootRequired {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toTree(cfg config.Provider) (interface{}, error) {
+	b, err := cfg.Bytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return nil, err
+	}
+
+	var tree interface{}
+
+	if err = yaml.Unmarshal(b, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+func diff(path []string, current, next interface{}, changes *[]Change) {
+	currentMap, currentIsMap := current.(map[string]interface{})
+	nextMap, nextIsMap := next.(map[string]interface{})
+
+	if !currentIsMap || !nextIsMap {
+		if !reflect.DeepEqual(current, next) {
+			*changes = append(*changes, newChange(path, OpModify))
+		}
+
+		return
+	}
+
+	keys := make([]string, 0, len(currentMap)+len(nextMap))
+
+	for key := range currentMap {
+		keys = append(keys, key)
+	}
+
+	for key := range nextMap {
+		if _, ok := currentMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		currentValue, inCurrent := currentMap[key]
+		nextValue, inNext := nextMap[key]
+
+		keyPath := append(append([]string(nil), path...), key)
+
+		switch {
+		case !inCurrent:
+			*changes = append(*changes, newChange(keyPath, OpAdd))
+		case !inNext:
+			*changes = append(*changes, newChange(keyPath, OpRemove))
+		default:
+			diff(keyPath, currentValue, nextValue, changes)
+		}
+	}
+}
+
+func newChange(path []string, op Op) Change {
+	p := strings.Join(path, ".")
+
+	return Change{
+		Path:           p,
+		Op:             op,
+		RebootRequired: !isImmediate(p),
+	}
+}
+
+func isImmediate(path string) bool {
+	for _, prefix := range ImmediatePaths {
+		if path == prefix || strings.HasPrefix(path, prefix+".") {
+			return true
+		}
+	}
+
+	return false
+}
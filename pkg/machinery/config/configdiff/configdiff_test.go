@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configdiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/configdiff"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+)
+
+const currentConfig = `version: v1alpha1
+machine:
+  type: worker
+  token: abc
+  network:
+    hostname: worker-1
+  sysctls:
+    net.ipv4.ip_forward: "1"
+  certSANs:
+    - 10.0.0.1
+cluster:
+  controlPlane:
+    endpoint: https://10.0.0.10:6443
+`
+
+func TestDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		next     string
+		expected []configdiff.Change
+	}{
+		{
+			name: "NoChanges",
+			next: currentConfig,
+		},
+		{
+			name: "Immediate",
+			next: `version: v1alpha1
+machine:
+  type: worker
+  token: abc
+  network:
+    hostname: worker-2
+    nameservers:
+      - 1.1.1.1
+  sysctls:
+    net.ipv4.ip_forward: "1"
+  certSANs:
+    - 10.0.0.1
+    - 10.0.0.2
+cluster:
+  controlPlane:
+    endpoint: https://10.0.0.11:6443
+`,
+			expected: []configdiff.Change{
+				{Path: "cluster.controlPlane.endpoint", Op: configdiff.OpModify},
+				{Path: "machine.certSANs", Op: configdiff.OpModify},
+				{Path: "machine.network.hostname", Op: configdiff.OpModify},
+				{Path: "machine.network.nameservers", Op: configdiff.OpAdd},
+			},
+		},
+		{
+			name: "RebootRequired",
+			next: `version: v1alpha1
+machine:
+  type: worker
+  token: abc
+  network:
+    hostname: worker-1
+  sysctls:
+    vm.overcommit_memory: "1"
+  certSANs:
+    - 10.0.0.1
+  kubelet:
+    image: ghcr.io/talos-systems/kubelet:v1.21.2
+cluster:
+  controlPlane:
+    endpoint: https://10.0.0.10:6443
+`,
+			expected: []configdiff.Change{
+				{Path: "machine.kubelet", Op: configdiff.OpAdd, RebootRequired: true},
+				{Path: "machine.sysctls.net.ipv4.ip_forward", Op: configdiff.OpRemove, RebootRequired: true},
+				{Path: "machine.sysctls.vm.overcommit_memory", Op: configdiff.OpAdd, RebootRequired: true},
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			current, err := configloader.NewFromBytes([]byte(currentConfig))
+			require.NoError(t, err)
+
+			next, err := configloader.NewFromBytes([]byte(tt.next))
+			require.NoError(t, err)
+
+			changes, err := configdiff.Diff(current, next)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, changes)
+			assert.Equal(t, tt.name == "RebootRequired", configdiff.RebootRequired(changes))
+		})
+	}
+}
@@ -49,6 +49,17 @@ type MachineConfig interface {
 	Registries() Registries
 	SystemDiskEncryption() SystemDiskEncryption
 	Features() Features
+	ServiceResources() map[string]ServiceResources
+	FileLimit() uint64
+	IMA() IMA
+	RedactPatterns() []string
+	ServiceLogSize() uint64
+	ServiceLogFormat() string
+	LogDestinations() []LogDestination
+	APIListenSubnets() []string
+	APIRateLimits() map[string]APIRateLimit
+	MetricsListenAddress() string
+	ReadinessListenAddress() string
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -60,8 +71,15 @@ type Disk interface {
 
 // Partition represents the options for a device partition.
 type Partition interface {
+	// Size returns the size in bytes, it is zero if the size is relative or the partition occupies the rest of the disk.
 	Size() uint64
+	// RelativeSize returns the percentage of the disk size (or of the space left after the other partitions if free is set).
+	RelativeSize() (percent float64, free bool)
 	MountPoint() string
+	// Filesystem returns the filesystem type the partition is formatted with.
+	Filesystem() string
+	// PersistentVolumes indicates that the partition backs persistent volumes of the pods.
+	PersistentVolumes() bool
 }
 
 // Env represents a set of environment variables.
@@ -73,6 +91,7 @@ type File interface {
 	Permissions() os.FileMode
 	Path() string
 	Op() string
+	Template() bool
 }
 
 // Install defines the requirements for a config that pertains to install
@@ -82,6 +101,7 @@ type Install interface {
 	Disk() (string, error)
 	ExtraKernelArgs() []string
 	Zero() bool
+	WipeMode() string
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
 }
@@ -90,6 +110,7 @@ type Install interface {
 // related options.
 type Security interface {
 	CA() *x509.PEMEncodedCertificateAndKey
+	AcceptedCAs() []*x509.PEMEncodedCertificateAndKey
 	Token() string
 	CertSANs() []string
 }
@@ -101,6 +122,16 @@ type MachineNetwork interface {
 	Resolvers() []string
 	Devices() []Device
 	ExtraHosts() []ExtraHost
+	AddressFilters() []AddressFilter
+}
+
+// AddressFilter represents a named filter of the node addresses.
+type AddressFilter interface {
+	Name() string
+	IncludeSubnets() []string
+	ExcludeSubnets() []string
+	IncludeInterfaces() []string
+	ExcludeInterfaces() []string
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -215,6 +246,18 @@ type Kubelet interface {
 	ExtraArgs() map[string]string
 	ExtraMounts() []specs.Mount
 	RegisterWithFQDN() bool
+	NodeIP() KubeletNodeIP
+	Reserved() KubeletReserved
+}
+
+// KubeletNodeIP defines the way node IPs are selected for the kubelet.
+type KubeletNodeIP interface {
+	ValidSubnets() []string
+}
+
+// KubeletReserved defines the way resources reserved for the system and Kubernetes daemons are computed.
+type KubeletReserved interface {
+	Policy() string
 }
 
 // Registries defines the configuration for image fetching.
@@ -266,6 +309,7 @@ type ClusterConfig interface {
 	CA() *x509.PEMEncodedCertificateAndKey
 	AggregatorCA() *x509.PEMEncodedCertificateAndKey
 	ServiceAccount() *x509.PEMEncodedKey
+	ServiceAccountAdditionalKeys() []*x509.PEMEncodedKey
 	AESCBCEncryptionSecret() string
 	Config(machine.Type) (string, error)
 	Etcd() Etcd
@@ -279,6 +323,7 @@ type ClusterConfig interface {
 	InlineManifests() []InlineManifest
 	AdminKubeconfig() AdminKubeconfig
 	ScheduleOnMasters() bool
+	StaticPodWatchdog() StaticPodWatchdog
 }
 
 // ClusterNetwork defines the requirements for a config that pertains to cluster
@@ -307,6 +352,7 @@ type APIServer interface {
 	Image() string
 	ExtraArgs() map[string]string
 	ExtraVolumes() []VolumeMount
+	DisableAggregator() bool
 }
 
 // ControllerManager defines the requirements for a config that pertains to controller manager related
@@ -372,6 +418,15 @@ type ExternalCloudProvider interface {
 // AdminKubeconfig defines settings for admin kubeconfig.
 type AdminKubeconfig interface {
 	CertLifetime() time.Duration
+	// Endpoint returns the API server endpoint for the admin kubeconfig, nil means the cluster endpoint.
+	Endpoint() *url.URL
+}
+
+// StaticPodWatchdog defines settings for restarting the unhealthy control plane static pods.
+type StaticPodWatchdog interface {
+	Enabled() bool
+	// UnhealthyThreshold is the time a static pod should stay unhealthy before it is restarted.
+	UnhealthyThreshold() time.Duration
 }
 
 // EncryptionKey defines settings for the partition encryption key handling.
@@ -406,6 +461,29 @@ type Features interface {
 	RBACEnabled() bool
 }
 
+// LogDestination describes a remote destination for the service logs.
+type LogDestination interface {
+	Endpoint() *url.URL
+}
+
+// IMA describes the IMA policy configuration.
+type IMA interface {
+	Profile() string
+	Rules() []string
+}
+
+// APIRateLimit describes a rate limit for the machine API method.
+type APIRateLimit interface {
+	Rate() float64
+	Burst() int
+}
+
+// ServiceResources describes cgroup resource limits for a system service.
+type ServiceResources interface {
+	MemoryMax() uint64
+	CPUWeight() uint64
+}
+
 // VolumeMount describes extra volume mount for the static pods.
 type VolumeMount interface {
 	Name() string
@@ -37,3 +37,8 @@ func (a *APIServerConfig) ExtraVolumes() []config.VolumeMount {
 
 	return volumes
 }
+
+// DisableAggregator implements the config.APIServer interface.
+func (a *APIServerConfig) DisableAggregator() bool {
+	return a.DisableAggregatorConfig
+}
@@ -73,6 +73,10 @@ func (c *ClusterConfig) Token() config.Token {
 
 // CertSANs implements the config.ClusterConfig interface.
 func (c *ClusterConfig) CertSANs() []string {
+	if c.APIServerConfig == nil {
+		return nil
+	}
+
 	return c.APIServerConfig.CertSANs
 }
 
@@ -91,6 +95,11 @@ func (c *ClusterConfig) ServiceAccount() *x509.PEMEncodedKey {
 	return c.ClusterServiceAccount
 }
 
+// ServiceAccountAdditionalKeys implements the config.ClusterConfig interface.
+func (c *ClusterConfig) ServiceAccountAdditionalKeys() []*x509.PEMEncodedKey {
+	return c.ClusterServiceAccountAdditionalKeys
+}
+
 // AESCBCEncryptionSecret implements the config.ClusterConfig interface.
 func (c *ClusterConfig) AESCBCEncryptionSecret() string {
 	return c.ClusterAESCBCEncryptionSecret
@@ -172,6 +181,15 @@ func (c *ClusterConfig) AdminKubeconfig() config.AdminKubeconfig {
 	return c.AdminKubeconfigConfig
 }
 
+// StaticPodWatchdog implements the config.ClusterConfig interface.
+func (c *ClusterConfig) StaticPodWatchdog() config.StaticPodWatchdog {
+	if c.StaticPodWatchdogConfig == nil {
+		return &StaticPodWatchdogConfig{}
+	}
+
+	return c.StaticPodWatchdogConfig
+}
+
 // ScheduleOnMasters implements the config.ClusterConfig interface.
 func (c *ClusterConfig) ScheduleOnMasters() bool {
 	return c.AllowSchedulingOnMasters
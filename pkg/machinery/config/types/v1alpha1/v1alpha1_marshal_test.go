@@ -147,3 +147,94 @@ func TestDiskSizeMatcherUnmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestDiskSize(t *testing.T) {
+	for _, test := range []struct {
+		input  string
+		output string
+		bytes  uint64
+	}{
+		{
+			input:  "100 MB",
+			output: "100 MB",
+			bytes:  100000000,
+		},
+		{
+			input:  "1073741824",
+			output: "1073741824",
+			bytes:  1073741824,
+		},
+	} {
+		var partition v1alpha1.DiskPartition
+
+		require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf("size: '%s'\n", test.input)), &partition), test.input)
+
+		assert.Equal(t, test.bytes, partition.Size(), test.input)
+
+		out, err := yaml.Marshal(&partition)
+		require.NoError(t, err)
+
+		assert.Equal(t, fmt.Sprintf("size: %s\n", test.output), string(out))
+	}
+}
+
+func TestRelativeDiskSize(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		percent  float64
+		free     bool
+		expecErr bool
+	}{
+		{
+			input:   "50%",
+			percent: 50,
+		},
+		{
+			input:   "12.5 %",
+			percent: 12.5,
+		},
+		{
+			input:   "100%FREE",
+			percent: 100,
+			free:    true,
+		},
+		{
+			input:    "150%",
+			expecErr: true,
+		},
+		{
+			input:    "50%USED",
+			expecErr: true,
+		},
+		{
+			input:    "abc%",
+			expecErr: true,
+		},
+		{
+			input:    "50",
+			expecErr: true,
+		},
+	} {
+		var partition v1alpha1.DiskPartition
+
+		require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf("relativeSize: '%s'\n", test.input)), &partition), test.input)
+
+		percent, free, err := partition.DiskRelativeSize.Parse()
+		if test.expecErr {
+			assert.Error(t, err, test.input)
+
+			continue
+		}
+
+		require.NoError(t, err, test.input)
+
+		assert.Equal(t, test.percent, percent, test.input)
+		assert.Equal(t, test.free, free, test.input)
+
+		percent, free = partition.RelativeSize()
+		assert.Equal(t, test.percent, percent, test.input)
+		assert.Equal(t, test.free, free, test.input)
+
+		assert.EqualValues(t, 0, partition.Size(), test.input)
+	}
+}
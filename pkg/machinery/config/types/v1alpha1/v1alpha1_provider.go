@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -243,6 +244,11 @@ func (m *MachineConfig) CA() *x509.PEMEncodedCertificateAndKey {
 	return m.MachineCA
 }
 
+// AcceptedCAs implements the config.Provider interface.
+func (m *MachineConfig) AcceptedCAs() []*x509.PEMEncodedCertificateAndKey {
+	return m.MachineAcceptedCAs
+}
+
 // Token implements the config.Provider interface.
 func (m *MachineConfig) Token() string {
 	return m.MachineToken
@@ -276,6 +282,150 @@ func (m *MachineConfig) Features() config.Features {
 	return m.MachineFeatures
 }
 
+// ServiceResources implements the config.MachineConfig interface.
+func (m *MachineConfig) ServiceResources() map[string]config.ServiceResources {
+	resources := make(map[string]config.ServiceResources, len(m.MachineServiceResources))
+
+	for service, r := range m.MachineServiceResources {
+		if r == nil {
+			continue
+		}
+
+		resources[service] = r
+	}
+
+	return resources
+}
+
+// FileLimit implements the config.MachineConfig interface.
+func (m *MachineConfig) FileLimit() uint64 {
+	if m.MachineFileLimit == 0 {
+		return constants.DefaultFileLimit
+	}
+
+	return m.MachineFileLimit
+}
+
+// ServiceLogSize implements the config.MachineConfig interface.
+func (m *MachineConfig) ServiceLogSize() uint64 {
+	if m.MachineServiceLogSize == 0 {
+		return constants.DefaultServiceLogSize
+	}
+
+	return m.MachineServiceLogSize
+}
+
+// ServiceLogFormat implements the config.MachineConfig interface.
+func (m *MachineConfig) ServiceLogFormat() string {
+	if m.MachineServiceLogFormat == "" {
+		return constants.ServiceLogFormatText
+	}
+
+	return m.MachineServiceLogFormat
+}
+
+// LogDestinations implements the config.MachineConfig interface.
+func (m *MachineConfig) LogDestinations() []config.LogDestination {
+	destinations := make([]config.LogDestination, len(m.MachineLogDestinations))
+
+	for i, d := range m.MachineLogDestinations {
+		destinations[i] = d
+	}
+
+	return destinations
+}
+
+// APIListenSubnets implements the config.MachineConfig interface.
+func (m *MachineConfig) APIListenSubnets() []string {
+	return m.MachineAPIListenSubnets
+}
+
+// APIRateLimits implements the config.MachineConfig interface.
+func (m *MachineConfig) APIRateLimits() map[string]config.APIRateLimit {
+	limits := make(map[string]config.APIRateLimit, len(m.MachineAPIRateLimits))
+
+	for method, limit := range m.MachineAPIRateLimits {
+		if limit == nil {
+			limit = &APIRateLimitConfig{}
+		}
+
+		limits[method] = limit
+	}
+
+	return limits
+}
+
+// MetricsListenAddress implements the config.MachineConfig interface.
+func (m *MachineConfig) MetricsListenAddress() string {
+	return m.MachineMetricsListenAddress
+}
+
+// ReadinessListenAddress implements the config.MachineConfig interface.
+func (m *MachineConfig) ReadinessListenAddress() string {
+	return m.MachineReadinessListenAddress
+}
+
+// Rate implements the config.APIRateLimit interface.
+func (l *APIRateLimitConfig) Rate() float64 {
+	return l.APIRateLimitRate
+}
+
+// Burst implements the config.APIRateLimit interface.
+func (l *APIRateLimitConfig) Burst() int {
+	if l.APIRateLimitBurst == 0 {
+		return 1
+	}
+
+	return l.APIRateLimitBurst
+}
+
+// Endpoint implements the config.LogDestination interface.
+func (d *LogDestinationConfig) Endpoint() *url.URL {
+	if d.LogEndpoint == nil {
+		return nil
+	}
+
+	return d.LogEndpoint.URL
+}
+
+// IMA implements the config.MachineConfig interface.
+func (m *MachineConfig) IMA() config.IMA {
+	if m.MachineIMA == nil {
+		return &IMAConfig{}
+	}
+
+	return m.MachineIMA
+}
+
+// RedactPatterns implements the config.MachineConfig interface.
+func (m *MachineConfig) RedactPatterns() []string {
+	return m.MachineRedactPatterns
+}
+
+// Profile implements the config.IMA interface.
+func (i *IMAConfig) Profile() string {
+	if i.IMAProfile == "" {
+		return constants.IMAProfileDefault
+	}
+
+	return i.IMAProfile
+}
+
+// Rules implements the config.IMA interface.
+func (i *IMAConfig) Rules() []string {
+	return i.IMARules
+}
+
+// MemoryMax implements the config.ServiceResources interface.
+func (r *ServiceResourcesConfig) MemoryMax() uint64 {
+	return r.ServiceMemoryMax
+}
+
+// CPUWeight implements the config.ServiceResources interface.
+func (r *ServiceResourcesConfig) CPUWeight() uint64 {
+	return r.ServiceCPUWeight
+}
+
 // Image implements the config.Provider interface.
 func (k *KubeletConfig) Image() string {
 	image := k.KubeletImage
@@ -325,6 +475,30 @@ func (k *KubeletConfig) RegisterWithFQDN() bool {
 	return k.KubeletRegisterWithFQDN
 }
 
+// NodeIP implements the config.Provider interface.
+func (k *KubeletConfig) NodeIP() config.KubeletNodeIP {
+	return k.KubeletNodeIP
+}
+
+// ValidSubnets implements the config.Provider interface.
+func (k KubeletNodeIPConfig) ValidSubnets() []string {
+	return k.KubeletNodeIPValidSubnets
+}
+
+// Reserved implements the config.Provider interface.
+func (k *KubeletConfig) Reserved() config.KubeletReserved {
+	return k.KubeletReserved
+}
+
+// Policy implements the config.Provider interface.
+func (k KubeletReservedConfig) Policy() string {
+	if k.KubeletReservedPolicy == "" {
+		return constants.KubeletReservedPolicyNone
+	}
+
+	return k.KubeletReservedPolicy
+}
+
 // Mirrors implements the Registries interface.
 func (r *RegistriesConfig) Mirrors() map[string]config.RegistryMirrorConfig {
 	mirrors := make(map[string]config.RegistryMirrorConfig, len(r.RegistryMirrors))
@@ -476,6 +650,42 @@ func (n *NetworkConfig) ExtraHosts() []config.ExtraHost {
 	return hosts
 }
 
+// AddressFilters implements the config.Provider interface.
+func (n *NetworkConfig) AddressFilters() []config.AddressFilter {
+	filters := make([]config.AddressFilter, len(n.NetworkAddressFilters))
+
+	for i := 0; i < len(n.NetworkAddressFilters); i++ {
+		filters[i] = n.NetworkAddressFilters[i]
+	}
+
+	return filters
+}
+
+// Name implements the config.AddressFilter interface.
+func (f *AddressFilter) Name() string {
+	return f.FilterName
+}
+
+// IncludeSubnets implements the config.AddressFilter interface.
+func (f *AddressFilter) IncludeSubnets() []string {
+	return f.FilterIncludeSubnets
+}
+
+// ExcludeSubnets implements the config.AddressFilter interface.
+func (f *AddressFilter) ExcludeSubnets() []string {
+	return f.FilterExcludeSubnets
+}
+
+// IncludeInterfaces implements the config.AddressFilter interface.
+func (f *AddressFilter) IncludeInterfaces() []string {
+	return f.FilterIncludeInterfaces
+}
+
+// ExcludeInterfaces implements the config.AddressFilter interface.
+func (f *AddressFilter) ExcludeInterfaces() []string {
+	return f.FilterExcludeInterfaces
+}
+
 // IP implements the MachineNetwork interface.
 func (e *ExtraHost) IP() string {
 	return e.HostIP
@@ -927,6 +1137,11 @@ func (i *InstallConfig) Zero() bool {
 	return i.InstallWipe
 }
 
+// WipeMode implements the config.Provider interface.
+func (i *InstallConfig) WipeMode() string {
+	return i.InstallWipeMode
+}
+
 // LegacyBIOSSupport implements the config.Provider interface.
 func (i *InstallConfig) LegacyBIOSSupport() bool {
 	return i.InstallLegacyBIOSSupport
@@ -962,6 +1177,29 @@ func (a *AdminKubeconfigConfig) CertLifetime() time.Duration {
 	return a.AdminKubeconfigCertLifetime
 }
 
+// Endpoint implements the config.Provider interface.
+func (a *AdminKubeconfigConfig) Endpoint() *url.URL {
+	if a.AdminKubeconfigEndpoint == nil {
+		return nil
+	}
+
+	return a.AdminKubeconfigEndpoint.URL
+}
+
+// Enabled implements the config.StaticPodWatchdog interface.
+func (w *StaticPodWatchdogConfig) Enabled() bool {
+	return !w.WatchdogDisabled
+}
+
+// UnhealthyThreshold implements the config.StaticPodWatchdog interface.
+func (w *StaticPodWatchdogConfig) UnhealthyThreshold() time.Duration {
+	if w.WatchdogUnhealthyThreshold == 0 {
+		return constants.StaticPodWatchdogDefaultUnhealthyThreshold
+	}
+
+	return w.WatchdogUnhealthyThreshold
+}
+
 // Endpoints implements the config.Provider interface.
 func (r *RegistryMirrorConfig) Endpoints() []string {
 	return r.MirrorEndpoints
@@ -987,6 +1225,11 @@ func (f *MachineFile) Op() string {
 	return f.FileOp
 }
 
+// Template implements the config.Provider interface.
+func (f *MachineFile) Template() bool {
+	return f.FileTemplate
+}
+
 // Device implements the config.Provider interface.
 func (d *MachineDisk) Device() string {
 	return d.DeviceName
@@ -1008,11 +1251,37 @@ func (p *DiskPartition) Size() uint64 {
 	return uint64(p.DiskSize)
 }
 
+// RelativeSize implements the config.Provider interface.
+func (p *DiskPartition) RelativeSize() (percent float64, free bool) {
+	if p.DiskRelativeSize == "" {
+		return 0, false
+	}
+
+	// relative size is checked by the config validation
+	percent, free, _ = p.DiskRelativeSize.Parse() //nolint:errcheck
+
+	return percent, free
+}
+
 // MountPoint implements the config.Provider interface.
 func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
 
+// Filesystem implements the config.Provider interface.
+func (p *DiskPartition) Filesystem() string {
+	if p.DiskFilesystem == "" {
+		return constants.DefaultDiskFilesystem
+	}
+
+	return p.DiskFilesystem
+}
+
+// PersistentVolumes implements the config.Provider interface.
+func (p *DiskPartition) PersistentVolumes() bool {
+	return p.DiskPersistentVolumes
+}
+
 // Kind implements the config.Provider interface.
 func (e *EncryptionConfig) Kind() string {
 	return e.EncryptionProvider
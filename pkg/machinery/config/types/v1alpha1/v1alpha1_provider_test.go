@@ -105,7 +105,10 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*config.Etcd)(nil), (*v1alpha1.EtcdConfig)(nil))
 	assert.Implements(t, (*config.ExternalCloudProvider)(nil), (*v1alpha1.ExternalCloudProviderConfig)(nil))
 	assert.Implements(t, (*config.Features)(nil), (*v1alpha1.FeaturesConfig)(nil))
+	assert.Implements(t, (*config.Kubelet)(nil), (*v1alpha1.KubeletConfig)(nil))
+	assert.Implements(t, (*config.KubeletReserved)(nil), (*v1alpha1.KubeletReservedConfig)(nil))
 	assert.Implements(t, (*config.MachineConfig)(nil), (*v1alpha1.MachineConfig)(nil))
 	assert.Implements(t, (*config.Scheduler)(nil), (*v1alpha1.SchedulerConfig)(nil))
+	assert.Implements(t, (*config.ServiceResources)(nil), (*v1alpha1.ServiceResourcesConfig)(nil))
 	assert.Implements(t, (*config.Token)(nil), (*v1alpha1.ClusterConfig)(nil))
 }
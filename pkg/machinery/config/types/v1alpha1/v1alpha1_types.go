@@ -173,6 +173,11 @@ var (
 		},
 	}
 
+	machineDiskRelativeSizeExamples = []RelativeDiskSize{
+		"50%",
+		"100%FREE",
+	}
+
 	machineInstallExample = &InstallConfig{
 		InstallDisk:            "/dev/sda",
 		InstallExtraKernelArgs: []string{"console=ttyS1", "panic=10"},
@@ -249,6 +254,39 @@ var (
 		RBAC: pointer.ToBool(true),
 	}
 
+	machineServiceResourcesExample = map[string]*ServiceResourcesConfig{
+		"kubelet": {
+			ServiceMemoryMax: 512 * 1024 * 1024,
+			ServiceCPUWeight: 50,
+		},
+		"etcd": {
+			ServiceCPUWeight: 200,
+		},
+	}
+
+	machineAPIRateLimitsExample = map[string]*APIRateLimitConfig{
+		"/machine.MachineService/Stats": {
+			APIRateLimitRate:  2,
+			APIRateLimitBurst: 10,
+		},
+		"/machine.MachineService/SupportBundle": {},
+	}
+
+	machineIMAExample = &IMAConfig{
+		IMAProfile: constants.IMAProfileExec,
+		IMARules: []string{
+			"measure func=FILE_CHECK mask=^MAY_READ uid=0 fowner=0",
+		},
+	}
+
+	machineLogDestinationsExample = []*LogDestinationConfig{
+		{
+			LogEndpoint: &Endpoint{
+				mustParseURL("tls://logs.example.com:6514"),
+			},
+		},
+	}
+
 	clusterConfigExample = struct {
 		ControlPlane *ControlPlaneConfig   `yaml:"controlPlane"`
 		ClusterName  string                `yaml:"clusterName"`
@@ -346,6 +384,14 @@ var (
 		AdminKubeconfigCertLifetime: time.Hour,
 	}
 
+	clusterStaticPodWatchdogExample = &StaticPodWatchdogConfig{
+		WatchdogUnhealthyThreshold: 10 * time.Minute,
+	}
+
+	clusterAdminKubeconfigEndpointExample = &Endpoint{
+		mustParseURL("https://10.5.0.100:6443"),
+	}
+
 	clusterEndpointExample1 = &Endpoint{
 		mustParseURL("https://1.2.3.4:6443"),
 	}
@@ -366,6 +412,24 @@ var (
 		},
 	}
 
+	kubeletNodeIPExample = KubeletNodeIPConfig{
+		KubeletNodeIPValidSubnets: []string{
+			"10.0.0.0/8",
+		},
+	}
+
+	kubeletReservedExample = KubeletReservedConfig{
+		KubeletReservedPolicy: constants.KubeletReservedPolicyAuto,
+	}
+
+	networkConfigAddressFiltersExample = []*AddressFilter{
+		{
+			FilterName:              "internal",
+			FilterIncludeSubnets:    []string{"10.0.0.0/8"},
+			FilterExcludeInterfaces: []string{"kube-bridge"},
+		},
+	}
+
 	networkConfigExtraHostsExample = []*ExtraHost{
 		{
 			HostIP: "192.168.1.100",
@@ -625,6 +689,104 @@ type MachineConfig struct {
 	//   examples:
 	//     - value: machineFeaturesExample
 	MachineFeatures *FeaturesConfig `yaml:"features,omitempty"`
+	//   description: |
+	//     Resource limits for Talos system services.
+	//
+	//     Each configured service is placed into a dedicated cgroup with the limits applied.
+	//     Map key is the service name, supported services are `containerd`, `cri`, `etcd` and `kubelet`.
+	//   examples:
+	//     - value: machineServiceResourcesExample
+	MachineServiceResources map[string]*ServiceResourcesConfig `yaml:"serviceResources,omitempty"`
+	//   description: |
+	//     The maximum number of open file descriptors (`RLIMIT_NOFILE`) for Talos and the services it runs.
+	//
+	//     The value should not exceed the kernel limit `fs.nr_open`.
+	//     Defaults to 1048576.
+	MachineFileLimit uint64 `yaml:"fileLimit,omitempty"`
+	//   description: |
+	//     Configures the IMA (Integrity Measurement Architecture) policy loaded at boot.
+	//   examples:
+	//     - value: machineIMAExample
+	MachineIMA *IMAConfig `yaml:"ima,omitempty"`
+	//   description: |
+	//     Additional regular expressions to redact from the support bundle.
+	//
+	//     Private keys, tokens, passwords and the secrets of the machine configuration are always redacted.
+	//     If the expression contains a capture group, only the first group is replaced.
+	//   examples:
+	//     - value: '[]string{`api_key=(\S+)`}'
+	MachineRedactPatterns []string `yaml:"redactPatterns,omitempty"`
+	//   description: |
+	//     The maximum size of the in-memory log kept for each service, in bytes.
+	//
+	//     Once the log reaches this size, the oldest entries are dropped.
+	//     The new size applies to the logs of the services started after the configuration is loaded.
+	//     Defaults to 1048576 (1 MiB).
+	MachineServiceLogSize uint64 `yaml:"serviceLogSize,omitempty"`
+	//   description: |
+	//     The format of the service logs.
+	//
+	//     With `json`, each line of the service output is wrapped into a JSON record
+	//     with the timestamp (`ts`), the service name (`service`) and the line itself (`msg`).
+	//     The format applies to the logs of the services started after the configuration is loaded.
+	//   values:
+	//     - text
+	//     - json
+	MachineServiceLogFormat string `yaml:"serviceLogFormat,omitempty"`
+	//   description: |
+	//     Remote destinations to forward the service logs to.
+	//
+	//     Logs are sent in the syslog format (RFC 5424).
+	//     Events are buffered in memory while the destination is unreachable, and the oldest events are dropped once the buffer is full.
+	//   examples:
+	//     - value: machineLogDestinationsExample
+	MachineLogDestinations []*LogDestinationConfig `yaml:"logDestinations,omitempty"`
+	//   description: |
+	//     The list of the additional base64 encoded CA certificates trusted by the machine API.
+	//
+	//     Client certificates signed by any of these CAs are accepted by the machine API in addition to the certificates signed by `ca`.
+	//     Only the certificates are used, private keys should not be specified.
+	//     This allows to rotate the machine CA without losing access to the machine:
+	//     add the new CA to this list, issue the client certificates with it, set the new CA as `ca`
+	//     moving the old one to this list, and remove the old CA once it is no longer used.
+	MachineAcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs,omitempty"`
+	//   description: |
+	//     The list of subnets (in CIDR notation) the machine API is available on.
+	//
+	//     If set, the machine API accepts connections only on the node addresses within these subnets
+	//     from the peers within these subnets (and on the loopback interface), other connections are closed
+	//     before the TLS handshake.
+	//     By default, the machine API is available on all node addresses.
+	//   examples:
+	//     - value: '[]string{"10.0.0.0/24", "fd00::/64"}'
+	MachineAPIListenSubnets []string `yaml:"apiListenSubnets,omitempty"`
+	//   description: |
+	//     Rate limits for the machine API calls.
+	//
+	//     Map key is the full name of the API method, e.g. `/machine.MachineService/Stats`.
+	//     Calls are limited per client (identified by the client certificate), calls over the limit fail with `ResourceExhausted` error.
+	//     By default, `Stats`, `Processes` and `SupportBundle` calls are limited, an empty limit removes the default one.
+	//   examples:
+	//     - value: machineAPIRateLimitsExample
+	MachineAPIRateLimits map[string]*APIRateLimitConfig `yaml:"apiRateLimits,omitempty"`
+	//   description: |
+	//     The address to serve the metrics of the machine controllers on in the Prometheus format.
+	//
+	//     Metrics are served over plain HTTP without authentication on the `/metrics` path.
+	//     By default, metrics are not served.
+	//   examples:
+	//     - value: '":9101"'
+	MachineMetricsListenAddress string `yaml:"metricsListenAddress,omitempty"`
+	//   description: |
+	//     The address to serve the node readiness endpoint on.
+	//
+	//     The endpoint is served over plain HTTP without authentication on the `/readyz` path.
+	//     It responds with `200` once the time is in sync, the network is configured, the machine API certificates are issued,
+	//     and none of the running services fails its health check, and with `503` listing the failed checks otherwise.
+	//     By default, the endpoint is not served.
+	//   examples:
+	//     - value: '"127.0.0.1:9102"'
+	MachineReadinessListenAddress string `yaml:"readinessListenAddress,omitempty"`
 }
 
 // ClusterConfig represents the cluster-wide config values.
@@ -680,6 +842,15 @@ type ClusterConfig struct {
 	//       value: pemEncodedKeyExample
 	ClusterServiceAccount *x509.PEMEncodedKey `yaml:"serviceAccount,omitempty"`
 	//   description: |
+	//     The list of the additional base64 encoded service account keys.
+	//
+	//     Public keys of these keys are trusted by the API server to verify service account tokens,
+	//     but new tokens are signed only with the key from the `serviceAccount` field.
+	//     This allows to rotate the service account key without invalidating the existing tokens:
+	//     move the old key to this list, set the new key as `serviceAccount`, and remove the old key
+	//     once all the tokens signed with it have expired.
+	ClusterServiceAccountAdditionalKeys []*x509.PEMEncodedKey `yaml:"serviceAccountAdditionalKeys,omitempty"`
+	//   description: |
 	//     API server specific configuration options.
 	//   examples:
 	//     - value: clusterAPIServerExample
@@ -746,6 +917,11 @@ type ClusterConfig struct {
 	//     - value: clusterAdminKubeconfigExample
 	AdminKubeconfigConfig *AdminKubeconfigConfig `yaml:"adminKubeconfig,omitempty"`
 	//   description: |
+	//     Settings for restarting the control plane static pods which stay not ready for too long.
+	//   examples:
+	//     - value: clusterStaticPodWatchdogExample
+	StaticPodWatchdogConfig *StaticPodWatchdogConfig `yaml:"staticPodWatchdog,omitempty"`
+	//   description: |
 	//     Allows running workload on master nodes.
 	//   values:
 	//     - true
@@ -755,6 +931,17 @@ type ClusterConfig struct {
 	AllowSchedulingOnMasters bool `yaml:"allowSchedulingOnMasters,omitempty"`
 }
 
+// StaticPodWatchdogConfig contains the static pod watchdog settings.
+type StaticPodWatchdogConfig struct {
+	//   description: |
+	//     Disable restarting the unhealthy control plane static pods.
+	WatchdogDisabled bool `yaml:"disabled,omitempty"`
+	//   description: |
+	//     The time a control plane static pod should stay not ready before it is restarted (default is 5 minutes, minimum is 1 minute).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	WatchdogUnhealthyThreshold time.Duration `yaml:"unhealthyThreshold,omitempty"`
+}
+
 // ExtraMount wraps OCI Mount specification.
 type ExtraMount struct {
 	specs.Mount
@@ -811,6 +998,39 @@ type KubeletConfig struct {
 	//     - false
 	//     - no
 	KubeletRegisterWithFQDN bool `yaml:"registerWithFQDN,omitempty"`
+	//   description: |
+	//     The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.
+	//     This is used when a node has multiple addresses to choose from.
+	//   examples:
+	//     - value: kubeletNodeIPExample
+	KubeletNodeIP KubeletNodeIPConfig `yaml:"nodeIP,omitempty"`
+	//   description: |
+	//     The `reserved` field configures resources reserved for the system and Kubernetes daemons.
+	//     Reserved resources are subtracted from the node allocatable, so that pods can't use them.
+	//   examples:
+	//     - value: kubeletReservedExample
+	KubeletReserved KubeletReservedConfig `yaml:"reserved,omitempty"`
+}
+
+// KubeletNodeIPConfig represents the kubelet node IP configuration.
+type KubeletNodeIPConfig struct {
+	//   description: |
+	//     The `validSubnets` field configures the networks to pick kubelet node IP from.
+	//     For dual stack configuration, there should be two subnets: one for IPv4, another for IPv6.
+	//     If not specified, node IP is picked based on cluster service CIDRs: IPv4/IPv6 address or both.
+	KubeletNodeIPValidSubnets []string `yaml:"validSubnets,omitempty"`
+}
+
+// KubeletReservedConfig represents the kubelet reserved resources configuration.
+type KubeletReservedConfig struct {
+	//   description: |
+	//     The `policy` field configures how `--system-reserved` and `--kube-reserved` are computed.
+	//     With `auto` policy, reserved memory and CPU are computed based on the node capacity.
+	//     With `none` policy (default), no resources are reserved.
+	//   values:
+	//     - "none"
+	//     - "auto"
+	KubeletReservedPolicy string `yaml:"policy,omitempty"`
 }
 
 // NetworkConfig represents the machine's networking config values.
@@ -836,6 +1056,13 @@ type NetworkConfig struct {
 	//   examples:
 	//     - value: networkConfigExtraHostsExample
 	ExtraHostEntries []*ExtraHost `yaml:"extraHostEntries,omitempty"`
+	//   description: |
+	//     Named filters of the node addresses.
+	//     For each filter, `current-<name>` and `accumulative-<name>` node address resources
+	//     hold the node addresses passing the filter (see `talosctl get nodeaddresses`).
+	//   examples:
+	//     - value: networkConfigAddressFiltersExample
+	NetworkAddressFilters []*AddressFilter `yaml:"addressFilters,omitempty"`
 }
 
 // InstallConfig represents the installation options for preparing a node.
@@ -882,6 +1109,16 @@ type InstallConfig struct {
 	//     - no
 	InstallWipe bool `yaml:"wipe"`
 	//   description: |
+	//     The method used to wipe the installation disk if `wipe` is enabled.
+	//     `quick` discards (TRIMs) the whole disk if the disk supports it, ignoring the errors, and zeroes out the first 1 MiB of the disk,
+	//     `discard` discards (TRIMs) the whole disk failing if the disk doesn't support it, and `zero` writes zeroes across the whole disk.
+	//     By default, the disk is zeroed out using the fastest available method.
+	//   values:
+	//     - quick
+	//     - discard
+	//     - zero
+	InstallWipeMode string `yaml:"wipeMode,omitempty"`
+	//   description: |
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport bool `yaml:"legacyBIOSSupport,omitempty"`
@@ -1029,8 +1266,9 @@ type TimeConfig struct {
 	TimeDisabled bool `yaml:"disabled"`
 	//   description: |
 	//     Specifies time (NTP) servers to use for setting the system time.
+	//     Each entry should be either an IP address or a DNS name, servers are tried in order.
 	//     Defaults to `pool.ntp.org`
-	TimeServers []string `yaml:"servers,omitempty"` // This parameter only supports a single time server.
+	TimeServers []string `yaml:"servers,omitempty"`
 }
 
 // RegistriesConfig represents the image pull options.
@@ -1157,6 +1395,11 @@ type APIServerConfig struct {
 	//   description: |
 	//     Extra certificate subject alternative names for the API server's certificate.
 	CertSANs []string `yaml:"certSANs,omitempty"`
+	//   description: |
+	//     Disable API aggregation layer and generation of the front-proxy (aggregator) certificates.
+	//
+	//     Aggregated APIs (e.g. metrics-server) are not available if the aggregation layer is disabled.
+	DisableAggregatorConfig bool `yaml:"disableAggregator,omitempty"`
 }
 
 // ControllerManagerConfig represents the kube controller manager configuration options.
@@ -1324,6 +1567,15 @@ type AdminKubeconfigConfig struct {
 	//     Admin kubeconfig certificate lifetime (default is 1 year).
 	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
 	AdminKubeconfigCertLifetime time.Duration `yaml:"certLifetime,omitempty"`
+	//   description: |
+	//     The Kubernetes API server endpoint to put into the admin kubeconfig (default is the cluster control plane endpoint).
+	//
+	//     It allows to deliver the admin kubeconfig pointing to the endpoint which is not used by the cluster itself,
+	//     e.g. the shared virtual IP of the control plane nodes or an external load balancer.
+	//     The endpoint address should be listed in the API server certificate SANs (`.cluster.apiServer.certSANs`).
+	//   examples:
+	//     - value: clusterAdminKubeconfigEndpointExample
+	AdminKubeconfigEndpoint *Endpoint `yaml:"endpoint,omitempty"`
 }
 
 // MachineDisk represents the options available for partitioning, formatting, and
@@ -1371,6 +1623,39 @@ func (ds *DiskSize) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// RelativeDiskSize partition size relative to the disk size.
+type RelativeDiskSize string
+
+// Parse returns the percentage of the disk size, free is set if the percentage is of the space left after
+// the partitions with the fixed size.
+func (rs RelativeDiskSize) Parse() (percent float64, free bool, err error) {
+	size := strings.TrimSpace(string(rs))
+
+	i := strings.Index(size, "%")
+	if i < 0 {
+		return 0, false, fmt.Errorf("failed to parse relative disk size %q: missing %%", rs)
+	}
+
+	percent, err = strconv.ParseFloat(strings.TrimSpace(size[:i]), 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse relative disk size %q: %w", rs, err)
+	}
+
+	switch suffix := strings.TrimSpace(size[i+1:]); suffix {
+	case "":
+	case "FREE":
+		free = true
+	default:
+		return 0, false, fmt.Errorf("failed to parse relative disk size %q: unexpected suffix %q", rs, suffix)
+	}
+
+	if percent <= 0 || percent > 100 {
+		return 0, false, fmt.Errorf("failed to parse relative disk size %q: percentage should be in range (0, 100]", rs)
+	}
+
+	return percent, free, nil
+}
+
 // DiskPartition represents the options for a disk partition.
 type DiskPartition struct {
 	//   description: >
@@ -1382,9 +1667,31 @@ type DiskPartition struct {
 	//     - name: Precise value in bytes.
 	//       value: 1024 * 1024 * 1024
 	DiskSize DiskSize `yaml:"size,omitempty"`
+	//   description: >
+	//     The size of partition relative to the disk size: either a percentage of the disk (`50%`),
+	//     or a percentage of the space left after the partitions with the fixed or the relative
+	//     size (`100%FREE`).
+	//     Mutually exclusive with `size`.
+	//   examples:
+	//     - name: Percentage of the disk size.
+	//       value: machineDiskRelativeSizeExamples[0]
+	//     - name: Percentage of the space left after the other partitions.
+	//       value: machineDiskRelativeSizeExamples[1]
+	DiskRelativeSize RelativeDiskSize `yaml:"relativeSize,omitempty"`
 	//   description:
 	//     Where to mount the partition.
 	DiskMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     The filesystem to format the partition with.
+	//     Defaults to `xfs`, which is the only filesystem the Talos root filesystem ships the tools for.
+	//   values:
+	//     - xfs
+	DiskFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     Indicates that the partition backs persistent volumes of the pods.
+	//     On reboot, shutdown and upgrade pod mounts of the partition are unmounted
+	//     after the processes of the pods using them exit (waiting for up to a minute).
+	DiskPersistentVolumes bool `yaml:"persistentVolumes,omitempty"`
 }
 
 // EncryptionConfig represents partition encryption settings.
@@ -1452,7 +1759,12 @@ type MachineFile struct {
 	FileContent string `yaml:"content"`
 	//   description: The file's permissions in octal.
 	FilePermissions FileMode `yaml:"permissions"`
-	//   description: The path of the file.
+	//   description: |
+	//     The path of the file.
+	//
+	//     New files can be created under `/var` and the writable overlays on top of the rootfs:
+	//     `/etc/kubernetes`, `/etc/cni`, `/usr/libexec/kubernetes`, `/usr/etc/udev` and `/opt`.
+	//     Other files should already exist to be appended or overwritten.
 	FilePath string `yaml:"path"`
 	//   description: The operation to use
 	//   values:
@@ -1460,6 +1772,31 @@ type MachineFile struct {
 	//     - append
 	//     - overwrite
 	FileOp string `yaml:"op"`
+	//   description: |
+	//     Render the content as a Go template before writing the file.
+	//
+	//     Available variables are `.Hostname`, `.Domainname`, `.FQDN`,
+	//     `.IP` (the first node address) and `.IPs` (all node addresses).
+	FileTemplate bool `yaml:"template,omitempty"`
+}
+
+// AddressFilter represents a named filter of the node addresses.
+type AddressFilter struct {
+	//   description: |
+	//     The name of the filter, it should be unique.
+	FilterName string `yaml:"name"`
+	//   description: |
+	//     Addresses are kept only if they belong to any of the subnets (if set).
+	FilterIncludeSubnets []string `yaml:"includeSubnets,omitempty"`
+	//   description: |
+	//     Addresses are skipped if they belong to any of the subnets.
+	FilterExcludeSubnets []string `yaml:"excludeSubnets,omitempty"`
+	//   description: |
+	//     Addresses are kept only if they are assigned to any of the interfaces (if set).
+	FilterIncludeInterfaces []string `yaml:"includeInterfaces,omitempty"`
+	//   description: |
+	//     Addresses are skipped if they are assigned to any of the interfaces.
+	FilterExcludeInterfaces []string `yaml:"excludeInterfaces,omitempty"`
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -1802,6 +2139,56 @@ type FeaturesConfig struct {
 	RBAC *bool `yaml:"rbac,omitempty"`
 }
 
+// ServiceResourcesConfig describes cgroup resource limits for a system service.
+type ServiceResourcesConfig struct {
+	//   description: |
+	//     Maximum amount of memory the service can use, in bytes.
+	//     Zero value means no limit.
+	ServiceMemoryMax uint64 `yaml:"memoryMax,omitempty"`
+	//   description: |
+	//     Relative CPU weight of the service in the range of 1-10000, default weight is 100.
+	//     Zero value means default weight.
+	ServiceCPUWeight uint64 `yaml:"cpuWeight,omitempty"`
+}
+
+// LogDestinationConfig represents a remote destination for the service logs.
+type LogDestinationConfig struct {
+	//   description: |
+	//     The address of the syslog server: `tcp://<host>:<port>` or `tls://<host>:<port>`.
+	//
+	//     TLS server certificate is verified against the system CA bundle.
+	LogEndpoint *Endpoint `yaml:"endpoint"`
+}
+
+// APIRateLimitConfig describes a rate limit for the machine API method.
+type APIRateLimitConfig struct {
+	//   description: |
+	//     Number of calls per second allowed on average.
+	//     Zero value means no limit.
+	APIRateLimitRate float64 `yaml:"rate,omitempty"`
+	//   description: |
+	//     Maximum number of calls allowed at once, defaults to 1.
+	APIRateLimitBurst int `yaml:"burst,omitempty"`
+}
+
+// IMAConfig represents the IMA policy configuration.
+type IMAConfig struct {
+	//   description: |
+	//     Built-in IMA policy profile.
+	//     `default` measures executables, kernel modules, firmware and files read by root,
+	//     `exec` measures only executables, kernel modules and firmware,
+	//     `none` doesn't load any built-in rules.
+	//   values:
+	//     - "default"
+	//     - "exec"
+	//     - "none"
+	IMAProfile string `yaml:"profile,omitempty"`
+	//   description: |
+	//     Additional IMA policy rules appended to the rules of the profile.
+	//     Rule syntax is described in the kernel [documentation](https://www.kernel.org/doc/Documentation/ABI/testing/ima_policy).
+	IMARules []string `yaml:"rules,omitempty"`
+}
+
 // VolumeMountConfig struct describes extra volume mount for the static pods.
 type VolumeMountConfig struct {
 	//   description: |
@@ -14,8 +14,11 @@ var (
 	ConfigDoc                      encoder.Doc
 	MachineConfigDoc               encoder.Doc
 	ClusterConfigDoc               encoder.Doc
+	StaticPodWatchdogConfigDoc     encoder.Doc
 	ExtraMountDoc                  encoder.Doc
 	KubeletConfigDoc               encoder.Doc
+	KubeletNodeIPConfigDoc         encoder.Doc
+	KubeletReservedConfigDoc       encoder.Doc
 	NetworkConfigDoc               encoder.Doc
 	InstallConfigDoc               encoder.Doc
 	InstallDiskSizeMatcherDoc      encoder.Doc
@@ -42,6 +45,7 @@ var (
 	EncryptionKeyStaticDoc         encoder.Doc
 	EncryptionKeyNodeIDDoc         encoder.Doc
 	MachineFileDoc                 encoder.Doc
+	AddressFilterDoc               encoder.Doc
 	ExtraHostDoc                   encoder.Doc
 	DeviceDoc                      encoder.Doc
 	DHCPOptionsDoc                 encoder.Doc
@@ -57,6 +61,10 @@ var (
 	RegistryTLSConfigDoc           encoder.Doc
 	SystemDiskEncryptionConfigDoc  encoder.Doc
 	FeaturesConfigDoc              encoder.Doc
+	ServiceResourcesConfigDoc      encoder.Doc
+	LogDestinationConfigDoc        encoder.Doc
+	APIRateLimitConfigDoc          encoder.Doc
+	IMAConfigDoc                   encoder.Doc
 	VolumeMountConfigDoc           encoder.Doc
 	ClusterInlineManifestDoc       encoder.Doc
 )
@@ -120,7 +128,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 15)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 27)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -240,6 +248,86 @@ func init() {
 	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
 
 	MachineConfigDoc.Fields[14].AddExample("", machineFeaturesExample)
+	MachineConfigDoc.Fields[15].Name = "serviceResources"
+	MachineConfigDoc.Fields[15].Type = "map[string]ServiceResourcesConfig"
+	MachineConfigDoc.Fields[15].Note = ""
+	MachineConfigDoc.Fields[15].Description = "Resource limits for Talos system services.\n\nEach configured service is placed into a dedicated cgroup with the limits applied.\nMap key is the service name, supported services are `containerd`, `cri`, `etcd` and `kubelet`."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Resource limits for Talos system services."
+
+	MachineConfigDoc.Fields[15].AddExample("", machineServiceResourcesExample)
+	MachineConfigDoc.Fields[16].Name = "fileLimit"
+	MachineConfigDoc.Fields[16].Type = "uint64"
+	MachineConfigDoc.Fields[16].Note = ""
+	MachineConfigDoc.Fields[16].Description = "The maximum number of open file descriptors (`RLIMIT_NOFILE`) for Talos and the services it runs.\n\nThe value should not exceed the kernel limit `fs.nr_open`.\nDefaults to 1048576."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "The maximum number of open file descriptors (`RLIMIT_NOFILE`) for Talos and the services it runs."
+	MachineConfigDoc.Fields[17].Name = "ima"
+	MachineConfigDoc.Fields[17].Type = "IMAConfig"
+	MachineConfigDoc.Fields[17].Note = ""
+	MachineConfigDoc.Fields[17].Description = "Configures the IMA (Integrity Measurement Architecture) policy loaded at boot."
+	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Configures the IMA (Integrity Measurement Architecture) policy loaded at boot."
+
+	MachineConfigDoc.Fields[17].AddExample("", machineIMAExample)
+	MachineConfigDoc.Fields[18].Name = "redactPatterns"
+	MachineConfigDoc.Fields[18].Type = "[]string"
+	MachineConfigDoc.Fields[18].Note = ""
+	MachineConfigDoc.Fields[18].Description = "Additional regular expressions to redact from the support bundle.\n\nPrivate keys, tokens, passwords and the secrets of the machine configuration are always redacted.\nIf the expression contains a capture group, only the first group is replaced."
+	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Additional regular expressions to redact from the support bundle."
+
+	MachineConfigDoc.Fields[18].AddExample("", []string{`api_key=(\S+)`})
+	MachineConfigDoc.Fields[19].Name = "serviceLogSize"
+	MachineConfigDoc.Fields[19].Type = "uint64"
+	MachineConfigDoc.Fields[19].Note = ""
+	MachineConfigDoc.Fields[19].Description = "The maximum size of the in-memory log kept for each service, in bytes.\n\nOnce the log reaches this size, the oldest entries are dropped.\nThe new size applies to the logs of the services started after the configuration is loaded.\nDefaults to 1048576 (1 MiB)."
+	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "The maximum size of the in-memory log kept for each service, in bytes."
+	MachineConfigDoc.Fields[20].Name = "serviceLogFormat"
+	MachineConfigDoc.Fields[20].Type = "string"
+	MachineConfigDoc.Fields[20].Note = ""
+	MachineConfigDoc.Fields[20].Description = "The format of the service logs.\n\nWith `json`, each line of the service output is wrapped into a JSON record\nwith the timestamp (`ts`), the service name (`service`) and the line itself (`msg`).\nThe format applies to the logs of the services started after the configuration is loaded."
+	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "The format of the service logs."
+	MachineConfigDoc.Fields[20].Values = []string{
+		"text",
+		"json",
+	}
+	MachineConfigDoc.Fields[21].Name = "logDestinations"
+	MachineConfigDoc.Fields[21].Type = "[]LogDestinationConfig"
+	MachineConfigDoc.Fields[21].Note = ""
+	MachineConfigDoc.Fields[21].Description = "Remote destinations to forward the service logs to.\n\nLogs are sent in the syslog format (RFC 5424).\nEvents are buffered in memory while the destination is unreachable, and the oldest events are dropped once the buffer is full."
+	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Remote destinations to forward the service logs to."
+
+	MachineConfigDoc.Fields[21].AddExample("", machineLogDestinationsExample)
+	MachineConfigDoc.Fields[22].Name = "acceptedCAs"
+	MachineConfigDoc.Fields[22].Type = "[]PEMEncodedCertificateAndKey"
+	MachineConfigDoc.Fields[22].Note = ""
+	MachineConfigDoc.Fields[22].Description = "The list of the additional base64 encoded CA certificates trusted by the machine API.\n\nClient certificates signed by any of these CAs are accepted by the machine API in addition to the certificates signed by `ca`.\nOnly the certificates are used, private keys should not be specified.\nThis allows to rotate the machine CA without losing access to the machine:\nadd the new CA to this list, issue the client certificates with it, set the new CA as `ca`\nmoving the old one to this list, and remove the old CA once it is no longer used."
+	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "The list of the additional base64 encoded CA certificates trusted by the machine API."
+	MachineConfigDoc.Fields[23].Name = "apiListenSubnets"
+	MachineConfigDoc.Fields[23].Type = "[]string"
+	MachineConfigDoc.Fields[23].Note = ""
+	MachineConfigDoc.Fields[23].Description = "The list of subnets (in CIDR notation) the machine API is available on.\n\nIf set, the machine API accepts connections only on the node addresses within these subnets\nfrom the peers within these subnets (and on the loopback interface), other connections are closed\nbefore the TLS handshake.\nBy default, the machine API is available on all node addresses."
+	MachineConfigDoc.Fields[23].Comments[encoder.LineComment] = "The list of subnets (in CIDR notation) the machine API is available on."
+
+	MachineConfigDoc.Fields[23].AddExample("", []string{"10.0.0.0/24", "fd00::/64"})
+	MachineConfigDoc.Fields[24].Name = "apiRateLimits"
+	MachineConfigDoc.Fields[24].Type = "map[string]APIRateLimitConfig"
+	MachineConfigDoc.Fields[24].Note = ""
+	MachineConfigDoc.Fields[24].Description = "Rate limits for the machine API calls.\n\nMap key is the full name of the API method, e.g. `/machine.MachineService/Stats`.\nCalls are limited per client (identified by the client certificate), calls over the limit fail with `ResourceExhausted` error.\nBy default, `Stats`, `Processes` and `SupportBundle` calls are limited, an empty limit removes the default one."
+	MachineConfigDoc.Fields[24].Comments[encoder.LineComment] = "Rate limits for the machine API calls."
+
+	MachineConfigDoc.Fields[24].AddExample("", machineAPIRateLimitsExample)
+	MachineConfigDoc.Fields[25].Name = "metricsListenAddress"
+	MachineConfigDoc.Fields[25].Type = "string"
+	MachineConfigDoc.Fields[25].Note = ""
+	MachineConfigDoc.Fields[25].Description = "The address to serve the metrics of the machine controllers on in the Prometheus format.\n\nMetrics are served over plain HTTP without authentication on the `/metrics` path.\nBy default, metrics are not served."
+	MachineConfigDoc.Fields[25].Comments[encoder.LineComment] = "The address to serve the metrics of the machine controllers on in the Prometheus format."
+
+	MachineConfigDoc.Fields[25].AddExample("", ":9101")
+	MachineConfigDoc.Fields[26].Name = "readinessListenAddress"
+	MachineConfigDoc.Fields[26].Type = "string"
+	MachineConfigDoc.Fields[26].Note = ""
+	MachineConfigDoc.Fields[26].Description = "The address to serve the node readiness endpoint on.\n\nThe endpoint is served over plain HTTP without authentication on the `/readyz` path.\nIt responds with `200` once the time is in sync, the network is configured, the machine API certificates are issued,\nand none of the running services fails its health check, and with `503` listing the failed checks otherwise.\nBy default, the endpoint is not served."
+	MachineConfigDoc.Fields[26].Comments[encoder.LineComment] = "The address to serve the node readiness endpoint on."
+
+	MachineConfigDoc.Fields[26].AddExample("", "127.0.0.1:9102")
 
 	ClusterConfigDoc.Type = "ClusterConfig"
 	ClusterConfigDoc.Comments[encoder.LineComment] = "ClusterConfig represents the cluster-wide config values."
@@ -252,7 +340,7 @@ func init() {
 			FieldName: "cluster",
 		},
 	}
-	ClusterConfigDoc.Fields = make([]encoder.Doc, 20)
+	ClusterConfigDoc.Fields = make([]encoder.Doc, 22)
 	ClusterConfigDoc.Fields[0].Name = "controlPlane"
 	ClusterConfigDoc.Fields[0].Type = "ControlPlaneConfig"
 	ClusterConfigDoc.Fields[0].Note = ""
@@ -307,101 +395,136 @@ func init() {
 	ClusterConfigDoc.Fields[7].Comments[encoder.LineComment] = "The base64 encoded private key for service account token generation."
 
 	ClusterConfigDoc.Fields[7].AddExample("AggregatorCA example.", pemEncodedKeyExample)
-	ClusterConfigDoc.Fields[8].Name = "apiServer"
-	ClusterConfigDoc.Fields[8].Type = "APIServerConfig"
+	ClusterConfigDoc.Fields[8].Name = "serviceAccountAdditionalKeys"
+	ClusterConfigDoc.Fields[8].Type = "[]PEMEncodedKey"
 	ClusterConfigDoc.Fields[8].Note = ""
-	ClusterConfigDoc.Fields[8].Description = "API server specific configuration options."
-	ClusterConfigDoc.Fields[8].Comments[encoder.LineComment] = "API server specific configuration options."
-
-	ClusterConfigDoc.Fields[8].AddExample("", clusterAPIServerExample)
-	ClusterConfigDoc.Fields[9].Name = "controllerManager"
-	ClusterConfigDoc.Fields[9].Type = "ControllerManagerConfig"
+	ClusterConfigDoc.Fields[8].Description = "The list of the additional base64 encoded service account keys.\n\nPublic keys of these keys are trusted by the API server to verify service account tokens,\nbut new tokens are signed only with the key from the `serviceAccount` field.\nThis allows to rotate the service account key without invalidating the existing tokens:\nmove the old key to this list, set the new key as `serviceAccount`, and remove the old key\nonce all the tokens signed with it have expired."
+	ClusterConfigDoc.Fields[8].Comments[encoder.LineComment] = "The list of the additional base64 encoded service account keys."
+	ClusterConfigDoc.Fields[9].Name = "apiServer"
+	ClusterConfigDoc.Fields[9].Type = "APIServerConfig"
 	ClusterConfigDoc.Fields[9].Note = ""
-	ClusterConfigDoc.Fields[9].Description = "Controller manager server specific configuration options."
-	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[9].Description = "API server specific configuration options."
+	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "API server specific configuration options."
 
-	ClusterConfigDoc.Fields[9].AddExample("", clusterControllerManagerExample)
-	ClusterConfigDoc.Fields[10].Name = "proxy"
-	ClusterConfigDoc.Fields[10].Type = "ProxyConfig"
+	ClusterConfigDoc.Fields[9].AddExample("", clusterAPIServerExample)
+	ClusterConfigDoc.Fields[10].Name = "controllerManager"
+	ClusterConfigDoc.Fields[10].Type = "ControllerManagerConfig"
 	ClusterConfigDoc.Fields[10].Note = ""
-	ClusterConfigDoc.Fields[10].Description = "Kube-proxy server-specific configuration options"
-	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[10].Description = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
 
-	ClusterConfigDoc.Fields[10].AddExample("", clusterProxyExample)
-	ClusterConfigDoc.Fields[11].Name = "scheduler"
-	ClusterConfigDoc.Fields[11].Type = "SchedulerConfig"
+	ClusterConfigDoc.Fields[10].AddExample("", clusterControllerManagerExample)
+	ClusterConfigDoc.Fields[11].Name = "proxy"
+	ClusterConfigDoc.Fields[11].Type = "ProxyConfig"
 	ClusterConfigDoc.Fields[11].Note = ""
-	ClusterConfigDoc.Fields[11].Description = "Scheduler server specific configuration options."
-	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[11].Description = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
 
-	ClusterConfigDoc.Fields[11].AddExample("", clusterSchedulerExample)
-	ClusterConfigDoc.Fields[12].Name = "etcd"
-	ClusterConfigDoc.Fields[12].Type = "EtcdConfig"
+	ClusterConfigDoc.Fields[11].AddExample("", clusterProxyExample)
+	ClusterConfigDoc.Fields[12].Name = "scheduler"
+	ClusterConfigDoc.Fields[12].Type = "SchedulerConfig"
 	ClusterConfigDoc.Fields[12].Note = ""
-	ClusterConfigDoc.Fields[12].Description = "Etcd specific configuration options."
-	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[12].Description = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
 
-	ClusterConfigDoc.Fields[12].AddExample("", clusterEtcdExample)
-	ClusterConfigDoc.Fields[13].Name = "coreDNS"
-	ClusterConfigDoc.Fields[13].Type = "CoreDNS"
+	ClusterConfigDoc.Fields[12].AddExample("", clusterSchedulerExample)
+	ClusterConfigDoc.Fields[13].Name = "etcd"
+	ClusterConfigDoc.Fields[13].Type = "EtcdConfig"
 	ClusterConfigDoc.Fields[13].Note = ""
-	ClusterConfigDoc.Fields[13].Description = "Core DNS specific configuration options."
-	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[13].Description = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "Etcd specific configuration options."
 
-	ClusterConfigDoc.Fields[13].AddExample("", clusterCoreDNSExample)
-	ClusterConfigDoc.Fields[14].Name = "externalCloudProvider"
-	ClusterConfigDoc.Fields[14].Type = "ExternalCloudProviderConfig"
+	ClusterConfigDoc.Fields[13].AddExample("", clusterEtcdExample)
+	ClusterConfigDoc.Fields[14].Name = "coreDNS"
+	ClusterConfigDoc.Fields[14].Type = "CoreDNS"
 	ClusterConfigDoc.Fields[14].Note = ""
-	ClusterConfigDoc.Fields[14].Description = "External cloud provider configuration."
-	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[14].Description = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "Core DNS specific configuration options."
 
-	ClusterConfigDoc.Fields[14].AddExample("", clusterExternalCloudProviderConfigExample)
-	ClusterConfigDoc.Fields[15].Name = "extraManifests"
-	ClusterConfigDoc.Fields[15].Type = "[]string"
+	ClusterConfigDoc.Fields[14].AddExample("", clusterCoreDNSExample)
+	ClusterConfigDoc.Fields[15].Name = "externalCloudProvider"
+	ClusterConfigDoc.Fields[15].Type = "ExternalCloudProviderConfig"
 	ClusterConfigDoc.Fields[15].Note = ""
-	ClusterConfigDoc.Fields[15].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
+	ClusterConfigDoc.Fields[15].Description = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "External cloud provider configuration."
 
-	ClusterConfigDoc.Fields[15].AddExample("", []string{
+	ClusterConfigDoc.Fields[15].AddExample("", clusterExternalCloudProviderConfigExample)
+	ClusterConfigDoc.Fields[16].Name = "extraManifests"
+	ClusterConfigDoc.Fields[16].Type = "[]string"
+	ClusterConfigDoc.Fields[16].Note = ""
+	ClusterConfigDoc.Fields[16].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
+
+	ClusterConfigDoc.Fields[16].AddExample("", []string{
 		"https://www.example.com/manifest1.yaml",
 		"https://www.example.com/manifest2.yaml",
 	})
-	ClusterConfigDoc.Fields[16].Name = "extraManifestHeaders"
-	ClusterConfigDoc.Fields[16].Type = "map[string]string"
-	ClusterConfigDoc.Fields[16].Note = ""
-	ClusterConfigDoc.Fields[16].Description = "A map of key value pairs that will be added while fetching the extraManifests."
-	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[17].Name = "extraManifestHeaders"
+	ClusterConfigDoc.Fields[17].Type = "map[string]string"
+	ClusterConfigDoc.Fields[17].Note = ""
+	ClusterConfigDoc.Fields[17].Description = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
 
-	ClusterConfigDoc.Fields[16].AddExample("", map[string]string{
+	ClusterConfigDoc.Fields[17].AddExample("", map[string]string{
 		"Token":       "1234567",
 		"X-ExtraInfo": "info",
 	})
-	ClusterConfigDoc.Fields[17].Name = "inlineManifests"
-	ClusterConfigDoc.Fields[17].Type = "ClusterInlineManifests"
-	ClusterConfigDoc.Fields[17].Note = ""
-	ClusterConfigDoc.Fields[17].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
-
-	ClusterConfigDoc.Fields[17].AddExample("", clusterInlineManifestsExample)
-	ClusterConfigDoc.Fields[18].Name = "adminKubeconfig"
-	ClusterConfigDoc.Fields[18].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[18].Name = "inlineManifests"
+	ClusterConfigDoc.Fields[18].Type = "ClusterInlineManifests"
 	ClusterConfigDoc.Fields[18].Note = ""
-	ClusterConfigDoc.Fields[18].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
-	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+	ClusterConfigDoc.Fields[18].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
 
-	ClusterConfigDoc.Fields[18].AddExample("", clusterAdminKubeconfigExample)
-	ClusterConfigDoc.Fields[19].Name = "allowSchedulingOnMasters"
-	ClusterConfigDoc.Fields[19].Type = "bool"
+	ClusterConfigDoc.Fields[18].AddExample("", clusterInlineManifestsExample)
+	ClusterConfigDoc.Fields[19].Name = "adminKubeconfig"
+	ClusterConfigDoc.Fields[19].Type = "AdminKubeconfigConfig"
 	ClusterConfigDoc.Fields[19].Note = ""
-	ClusterConfigDoc.Fields[19].Description = "Allows running workload on master nodes."
-	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "Allows running workload on master nodes."
-	ClusterConfigDoc.Fields[19].Values = []string{
+	ClusterConfigDoc.Fields[19].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
+	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+
+	ClusterConfigDoc.Fields[19].AddExample("", clusterAdminKubeconfigExample)
+	ClusterConfigDoc.Fields[20].Name = "staticPodWatchdog"
+	ClusterConfigDoc.Fields[20].Type = "StaticPodWatchdogConfig"
+	ClusterConfigDoc.Fields[20].Note = ""
+	ClusterConfigDoc.Fields[20].Description = "Settings for restarting the control plane static pods which stay not ready for too long."
+	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "Settings for restarting the control plane static pods which stay not ready for too long."
+
+	ClusterConfigDoc.Fields[20].AddExample("", clusterStaticPodWatchdogExample)
+	ClusterConfigDoc.Fields[21].Name = "allowSchedulingOnMasters"
+	ClusterConfigDoc.Fields[21].Type = "bool"
+	ClusterConfigDoc.Fields[21].Note = ""
+	ClusterConfigDoc.Fields[21].Description = "Allows running workload on master nodes."
+	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "Allows running workload on master nodes."
+	ClusterConfigDoc.Fields[21].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
 
+	StaticPodWatchdogConfigDoc.Type = "StaticPodWatchdogConfig"
+	StaticPodWatchdogConfigDoc.Comments[encoder.LineComment] = "StaticPodWatchdogConfig contains the static pod watchdog settings."
+	StaticPodWatchdogConfigDoc.Description = "StaticPodWatchdogConfig contains the static pod watchdog settings."
+
+	StaticPodWatchdogConfigDoc.AddExample("", clusterStaticPodWatchdogExample)
+	StaticPodWatchdogConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "ClusterConfig",
+			FieldName: "staticPodWatchdog",
+		},
+	}
+	StaticPodWatchdogConfigDoc.Fields = make([]encoder.Doc, 2)
+	StaticPodWatchdogConfigDoc.Fields[0].Name = "disabled"
+	StaticPodWatchdogConfigDoc.Fields[0].Type = "bool"
+	StaticPodWatchdogConfigDoc.Fields[0].Note = ""
+	StaticPodWatchdogConfigDoc.Fields[0].Description = "Disable restarting the unhealthy control plane static pods."
+	StaticPodWatchdogConfigDoc.Fields[0].Comments[encoder.LineComment] = "Disable restarting the unhealthy control plane static pods."
+	StaticPodWatchdogConfigDoc.Fields[1].Name = "unhealthyThreshold"
+	StaticPodWatchdogConfigDoc.Fields[1].Type = "Duration"
+	StaticPodWatchdogConfigDoc.Fields[1].Note = ""
+	StaticPodWatchdogConfigDoc.Fields[1].Description = "The time a control plane static pod should stay not ready before it is restarted (default is 5 minutes, minimum is 1 minute).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	StaticPodWatchdogConfigDoc.Fields[1].Comments[encoder.LineComment] = "The time a control plane static pod should stay not ready before it is restarted (default is 5 minutes, minimum is 1 minute)."
+
 	ExtraMountDoc.Type = "ExtraMount"
 	ExtraMountDoc.Comments[encoder.LineComment] = "ExtraMount wraps OCI Mount specification."
 	ExtraMountDoc.Description = "ExtraMount wraps OCI Mount specification."
@@ -426,7 +549,7 @@ func init() {
 			FieldName: "kubelet",
 		},
 	}
-	KubeletConfigDoc.Fields = make([]encoder.Doc, 5)
+	KubeletConfigDoc.Fields = make([]encoder.Doc, 7)
 	KubeletConfigDoc.Fields[0].Name = "image"
 	KubeletConfigDoc.Fields[0].Type = "string"
 	KubeletConfigDoc.Fields[0].Note = ""
@@ -468,6 +591,60 @@ func init() {
 		"false",
 		"no",
 	}
+	KubeletConfigDoc.Fields[5].Name = "nodeIP"
+	KubeletConfigDoc.Fields[5].Type = "KubeletNodeIPConfig"
+	KubeletConfigDoc.Fields[5].Note = ""
+	KubeletConfigDoc.Fields[5].Description = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.\nThis is used when a node has multiple addresses to choose from."
+	KubeletConfigDoc.Fields[5].Comments[encoder.LineComment] = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet."
+
+	KubeletConfigDoc.Fields[5].AddExample("", kubeletNodeIPExample)
+	KubeletConfigDoc.Fields[6].Name = "reserved"
+	KubeletConfigDoc.Fields[6].Type = "KubeletReservedConfig"
+	KubeletConfigDoc.Fields[6].Note = ""
+	KubeletConfigDoc.Fields[6].Description = "The `reserved` field configures resources reserved for the system and Kubernetes daemons.\nReserved resources are subtracted from the node allocatable, so that pods can't use them."
+	KubeletConfigDoc.Fields[6].Comments[encoder.LineComment] = "The `reserved` field configures resources reserved for the system and Kubernetes daemons."
+
+	KubeletConfigDoc.Fields[6].AddExample("", kubeletReservedExample)
+
+	KubeletNodeIPConfigDoc.Type = "KubeletNodeIPConfig"
+	KubeletNodeIPConfigDoc.Comments[encoder.LineComment] = "KubeletNodeIPConfig represents the kubelet node IP configuration."
+	KubeletNodeIPConfigDoc.Description = "KubeletNodeIPConfig represents the kubelet node IP configuration."
+
+	KubeletNodeIPConfigDoc.AddExample("", kubeletNodeIPExample)
+	KubeletNodeIPConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "KubeletConfig",
+			FieldName: "nodeIP",
+		},
+	}
+	KubeletNodeIPConfigDoc.Fields = make([]encoder.Doc, 1)
+	KubeletNodeIPConfigDoc.Fields[0].Name = "validSubnets"
+	KubeletNodeIPConfigDoc.Fields[0].Type = "[]string"
+	KubeletNodeIPConfigDoc.Fields[0].Note = ""
+	KubeletNodeIPConfigDoc.Fields[0].Description = "The `validSubnets` field configures the networks to pick kubelet node IP from.\nFor dual stack configuration, there should be two subnets: one for IPv4, another for IPv6.\nIf not specified, node IP is picked based on cluster service CIDRs: IPv4/IPv6 address or both."
+	KubeletNodeIPConfigDoc.Fields[0].Comments[encoder.LineComment] = "The `validSubnets` field configures the networks to pick kubelet node IP from."
+
+	KubeletReservedConfigDoc.Type = "KubeletReservedConfig"
+	KubeletReservedConfigDoc.Comments[encoder.LineComment] = "KubeletReservedConfig represents the kubelet reserved resources configuration."
+	KubeletReservedConfigDoc.Description = "KubeletReservedConfig represents the kubelet reserved resources configuration."
+
+	KubeletReservedConfigDoc.AddExample("", kubeletReservedExample)
+	KubeletReservedConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "KubeletConfig",
+			FieldName: "reserved",
+		},
+	}
+	KubeletReservedConfigDoc.Fields = make([]encoder.Doc, 1)
+	KubeletReservedConfigDoc.Fields[0].Name = "policy"
+	KubeletReservedConfigDoc.Fields[0].Type = "string"
+	KubeletReservedConfigDoc.Fields[0].Note = ""
+	KubeletReservedConfigDoc.Fields[0].Description = "The `policy` field configures how `--system-reserved` and `--kube-reserved` are computed.\nWith `auto` policy, reserved memory and CPU are computed based on the node capacity.\nWith `none` policy (default), no resources are reserved."
+	KubeletReservedConfigDoc.Fields[0].Comments[encoder.LineComment] = "The `policy` field configures how `--system-reserved` and `--kube-reserved` are computed."
+	KubeletReservedConfigDoc.Fields[0].Values = []string{
+		"none",
+		"auto",
+	}
 
 	NetworkConfigDoc.Type = "NetworkConfig"
 	NetworkConfigDoc.Comments[encoder.LineComment] = "NetworkConfig represents the machine's networking config values."
@@ -480,7 +657,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 4)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 5)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -507,6 +684,13 @@ func init() {
 	NetworkConfigDoc.Fields[3].Comments[encoder.LineComment] = "Allows for extra entries to be added to the `/etc/hosts` file"
 
 	NetworkConfigDoc.Fields[3].AddExample("", networkConfigExtraHostsExample)
+	NetworkConfigDoc.Fields[4].Name = "addressFilters"
+	NetworkConfigDoc.Fields[4].Type = "[]AddressFilter"
+	NetworkConfigDoc.Fields[4].Note = ""
+	NetworkConfigDoc.Fields[4].Description = "Named filters of the node addresses.\nFor each filter, `current-<name>` and `accumulative-<name>` node address resources\nhold the node addresses passing the filter (see `talosctl get nodeaddresses`)."
+	NetworkConfigDoc.Fields[4].Comments[encoder.LineComment] = "Named filters of the node addresses."
+
+	NetworkConfigDoc.Fields[4].AddExample("", networkConfigAddressFiltersExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -519,7 +703,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 7)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 8)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -572,11 +756,21 @@ func init() {
 		"false",
 		"no",
 	}
-	InstallConfigDoc.Fields[6].Name = "legacyBIOSSupport"
-	InstallConfigDoc.Fields[6].Type = "bool"
+	InstallConfigDoc.Fields[6].Name = "wipeMode"
+	InstallConfigDoc.Fields[6].Type = "string"
 	InstallConfigDoc.Fields[6].Note = ""
-	InstallConfigDoc.Fields[6].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
-	InstallConfigDoc.Fields[6].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
+	InstallConfigDoc.Fields[6].Description = "The method used to wipe the installation disk if `wipe` is enabled.\n`quick` discards (TRIMs) the whole disk if the disk supports it, ignoring the errors, and zeroes out the first 1 MiB of the disk,\n`discard` discards (TRIMs) the whole disk failing if the disk doesn't support it, and `zero` writes zeroes across the whole disk.\nBy default, the disk is zeroed out using the fastest available method."
+	InstallConfigDoc.Fields[6].Comments[encoder.LineComment] = "The method used to wipe the installation disk if `wipe` is enabled."
+	InstallConfigDoc.Fields[6].Values = []string{
+		"quick",
+		"discard",
+		"zero",
+	}
+	InstallConfigDoc.Fields[7].Name = "legacyBIOSSupport"
+	InstallConfigDoc.Fields[7].Type = "bool"
+	InstallConfigDoc.Fields[7].Note = ""
+	InstallConfigDoc.Fields[7].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
+	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
 
 	InstallDiskSizeMatcherDoc.Type = "InstallDiskSizeMatcher"
 	InstallDiskSizeMatcherDoc.Comments[encoder.LineComment] = "InstallDiskSizeMatcher disk size condition parser."
@@ -679,8 +873,8 @@ func init() {
 	TimeConfigDoc.Fields[0].Comments[encoder.LineComment] = "Indicates if the time service is disabled for the machine."
 	TimeConfigDoc.Fields[1].Name = "servers"
 	TimeConfigDoc.Fields[1].Type = "[]string"
-	TimeConfigDoc.Fields[1].Note = "This parameter only supports a single time server.\n"
-	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`"
+	TimeConfigDoc.Fields[1].Note = ""
+	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nEach entry should be either an IP address or a DNS name, servers are tried in order.\nDefaults to `pool.ntp.org`"
 	TimeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies time (NTP) servers to use for setting the system time."
 
 	RegistriesConfigDoc.Type = "RegistriesConfig"
@@ -750,11 +944,21 @@ func init() {
 	EndpointDoc.AddExample("", clusterEndpointExample1)
 
 	EndpointDoc.AddExample("", clusterEndpointExample2)
+
+	EndpointDoc.AddExample("", clusterAdminKubeconfigEndpointExample)
 	EndpointDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "ControlPlaneConfig",
 			FieldName: "endpoint",
 		},
+		{
+			TypeName:  "AdminKubeconfigConfig",
+			FieldName: "endpoint",
+		},
+		{
+			TypeName:  "LogDestinationConfig",
+			FieldName: "endpoint",
+		},
 	}
 	EndpointDoc.Fields = make([]encoder.Doc, 0)
 
@@ -796,7 +1000,7 @@ func init() {
 			FieldName: "apiServer",
 		},
 	}
-	APIServerConfigDoc.Fields = make([]encoder.Doc, 4)
+	APIServerConfigDoc.Fields = make([]encoder.Doc, 5)
 	APIServerConfigDoc.Fields[0].Name = "image"
 	APIServerConfigDoc.Fields[0].Type = "string"
 	APIServerConfigDoc.Fields[0].Note = ""
@@ -819,6 +1023,11 @@ func init() {
 	APIServerConfigDoc.Fields[3].Note = ""
 	APIServerConfigDoc.Fields[3].Description = "Extra certificate subject alternative names for the API server's certificate."
 	APIServerConfigDoc.Fields[3].Comments[encoder.LineComment] = "Extra certificate subject alternative names for the API server's certificate."
+	APIServerConfigDoc.Fields[4].Name = "disableAggregator"
+	APIServerConfigDoc.Fields[4].Type = "bool"
+	APIServerConfigDoc.Fields[4].Note = ""
+	APIServerConfigDoc.Fields[4].Description = "Disable API aggregation layer and generation of the front-proxy (aggregator) certificates.\n\nAggregated APIs (e.g. metrics-server) are not available if the aggregation layer is disabled."
+	APIServerConfigDoc.Fields[4].Comments[encoder.LineComment] = "Disable API aggregation layer and generation of the front-proxy (aggregator) certificates."
 
 	ControllerManagerConfigDoc.Type = "ControllerManagerConfig"
 	ControllerManagerConfigDoc.Comments[encoder.LineComment] = "ControllerManagerConfig represents the kube controller manager configuration options."
@@ -1063,12 +1272,19 @@ func init() {
 			FieldName: "adminKubeconfig",
 		},
 	}
-	AdminKubeconfigConfigDoc.Fields = make([]encoder.Doc, 1)
+	AdminKubeconfigConfigDoc.Fields = make([]encoder.Doc, 2)
 	AdminKubeconfigConfigDoc.Fields[0].Name = "certLifetime"
 	AdminKubeconfigConfigDoc.Fields[0].Type = "Duration"
 	AdminKubeconfigConfigDoc.Fields[0].Note = ""
 	AdminKubeconfigConfigDoc.Fields[0].Description = "Admin kubeconfig certificate lifetime (default is 1 year).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
 	AdminKubeconfigConfigDoc.Fields[0].Comments[encoder.LineComment] = "Admin kubeconfig certificate lifetime (default is 1 year)."
+	AdminKubeconfigConfigDoc.Fields[1].Name = "endpoint"
+	AdminKubeconfigConfigDoc.Fields[1].Type = "Endpoint"
+	AdminKubeconfigConfigDoc.Fields[1].Note = ""
+	AdminKubeconfigConfigDoc.Fields[1].Description = "The Kubernetes API server endpoint to put into the admin kubeconfig (default is the cluster control plane endpoint).\n\nIt allows to deliver the admin kubeconfig pointing to the endpoint which is not used by the cluster itself,\ne.g. the shared virtual IP of the control plane nodes or an external load balancer.\nThe endpoint address should be listed in the API server certificate SANs (`.cluster.apiServer.certSANs`)."
+	AdminKubeconfigConfigDoc.Fields[1].Comments[encoder.LineComment] = "The Kubernetes API server endpoint to put into the admin kubeconfig (default is the cluster control plane endpoint)."
+
+	AdminKubeconfigConfigDoc.Fields[1].AddExample("", clusterAdminKubeconfigEndpointExample)
 
 	MachineDiskDoc.Type = "MachineDisk"
 	MachineDiskDoc.Comments[encoder.LineComment] = "MachineDisk represents the options available for partitioning, formatting, and"
@@ -1102,7 +1318,7 @@ func init() {
 			FieldName: "partitions",
 		},
 	}
-	DiskPartitionDoc.Fields = make([]encoder.Doc, 2)
+	DiskPartitionDoc.Fields = make([]encoder.Doc, 5)
 	DiskPartitionDoc.Fields[0].Name = "size"
 	DiskPartitionDoc.Fields[0].Type = "DiskSize"
 	DiskPartitionDoc.Fields[0].Note = ""
@@ -1112,11 +1328,33 @@ func init() {
 	DiskPartitionDoc.Fields[0].AddExample("Human readable representation.", DiskSize(100000000))
 
 	DiskPartitionDoc.Fields[0].AddExample("Precise value in bytes.", 1024*1024*1024)
-	DiskPartitionDoc.Fields[1].Name = "mountpoint"
-	DiskPartitionDoc.Fields[1].Type = "string"
+	DiskPartitionDoc.Fields[1].Name = "relativeSize"
+	DiskPartitionDoc.Fields[1].Type = "RelativeDiskSize"
 	DiskPartitionDoc.Fields[1].Note = ""
-	DiskPartitionDoc.Fields[1].Description = "Where to mount the partition."
-	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "Where to mount the partition."
+	DiskPartitionDoc.Fields[1].Description = "The size of partition relative to the disk size: either a percentage of the disk (`50%`), or a percentage of the space left after the partitions with the fixed or the relative size (`100%FREE`). Mutually exclusive with `size`."
+	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "The size of partition relative to the disk size: either a percentage of the disk (`50%`), or a percentage of the space left after the partitions with the fixed or the relative size (`100%FREE`). Mutually exclusive with `size`."
+
+	DiskPartitionDoc.Fields[1].AddExample("Percentage of the disk size.", machineDiskRelativeSizeExamples[0])
+
+	DiskPartitionDoc.Fields[1].AddExample("Percentage of the space left after the other partitions.", machineDiskRelativeSizeExamples[1])
+	DiskPartitionDoc.Fields[2].Name = "mountpoint"
+	DiskPartitionDoc.Fields[2].Type = "string"
+	DiskPartitionDoc.Fields[2].Note = ""
+	DiskPartitionDoc.Fields[2].Description = "Where to mount the partition."
+	DiskPartitionDoc.Fields[2].Comments[encoder.LineComment] = "Where to mount the partition."
+	DiskPartitionDoc.Fields[3].Name = "filesystem"
+	DiskPartitionDoc.Fields[3].Type = "string"
+	DiskPartitionDoc.Fields[3].Note = ""
+	DiskPartitionDoc.Fields[3].Description = "The filesystem to format the partition with.\nDefaults to `xfs`, which is the only filesystem the Talos root filesystem ships the tools for."
+	DiskPartitionDoc.Fields[3].Comments[encoder.LineComment] = "The filesystem to format the partition with."
+	DiskPartitionDoc.Fields[3].Values = []string{
+		"xfs",
+	}
+	DiskPartitionDoc.Fields[4].Name = "persistentVolumes"
+	DiskPartitionDoc.Fields[4].Type = "bool"
+	DiskPartitionDoc.Fields[4].Note = ""
+	DiskPartitionDoc.Fields[4].Description = "Indicates that the partition backs persistent volumes of the pods.\nOn reboot, shutdown and upgrade pod mounts of the partition are unmounted\nafter the processes of the pods using them exit (waiting for up to a minute)."
+	DiskPartitionDoc.Fields[4].Comments[encoder.LineComment] = "Indicates that the partition backs persistent volumes of the pods."
 
 	EncryptionConfigDoc.Type = "EncryptionConfig"
 	EncryptionConfigDoc.Comments[encoder.LineComment] = "EncryptionConfig represents partition encryption settings."
@@ -1214,7 +1452,7 @@ func init() {
 			FieldName: "files",
 		},
 	}
-	MachineFileDoc.Fields = make([]encoder.Doc, 4)
+	MachineFileDoc.Fields = make([]encoder.Doc, 5)
 	MachineFileDoc.Fields[0].Name = "content"
 	MachineFileDoc.Fields[0].Type = "string"
 	MachineFileDoc.Fields[0].Note = ""
@@ -1228,7 +1466,7 @@ func init() {
 	MachineFileDoc.Fields[2].Name = "path"
 	MachineFileDoc.Fields[2].Type = "string"
 	MachineFileDoc.Fields[2].Note = ""
-	MachineFileDoc.Fields[2].Description = "The path of the file."
+	MachineFileDoc.Fields[2].Description = "The path of the file.\n\nNew files can be created under `/var` and the writable overlays on top of the rootfs:\n`/etc/kubernetes`, `/etc/cni`, `/usr/libexec/kubernetes`, `/usr/etc/udev` and `/opt`.\nOther files should already exist to be appended or overwritten."
 	MachineFileDoc.Fields[2].Comments[encoder.LineComment] = "The path of the file."
 	MachineFileDoc.Fields[3].Name = "op"
 	MachineFileDoc.Fields[3].Type = "string"
@@ -1240,6 +1478,49 @@ func init() {
 		"append",
 		"overwrite",
 	}
+	MachineFileDoc.Fields[4].Name = "template"
+	MachineFileDoc.Fields[4].Type = "bool"
+	MachineFileDoc.Fields[4].Note = ""
+	MachineFileDoc.Fields[4].Description = "Render the content as a Go template before writing the file.\n\nAvailable variables are `.Hostname`, `.Domainname`, `.FQDN`,\n`.IP` (the first node address) and `.IPs` (all node addresses)."
+	MachineFileDoc.Fields[4].Comments[encoder.LineComment] = "Render the content as a Go template before writing the file."
+
+	AddressFilterDoc.Type = "AddressFilter"
+	AddressFilterDoc.Comments[encoder.LineComment] = "AddressFilter represents a named filter of the node addresses."
+	AddressFilterDoc.Description = "AddressFilter represents a named filter of the node addresses."
+
+	AddressFilterDoc.AddExample("", networkConfigAddressFiltersExample)
+	AddressFilterDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "addressFilters",
+		},
+	}
+	AddressFilterDoc.Fields = make([]encoder.Doc, 5)
+	AddressFilterDoc.Fields[0].Name = "name"
+	AddressFilterDoc.Fields[0].Type = "string"
+	AddressFilterDoc.Fields[0].Note = ""
+	AddressFilterDoc.Fields[0].Description = "The name of the filter, it should be unique."
+	AddressFilterDoc.Fields[0].Comments[encoder.LineComment] = "The name of the filter, it should be unique."
+	AddressFilterDoc.Fields[1].Name = "includeSubnets"
+	AddressFilterDoc.Fields[1].Type = "[]string"
+	AddressFilterDoc.Fields[1].Note = ""
+	AddressFilterDoc.Fields[1].Description = "Addresses are kept only if they belong to any of the subnets (if set)."
+	AddressFilterDoc.Fields[1].Comments[encoder.LineComment] = "Addresses are kept only if they belong to any of the subnets (if set)."
+	AddressFilterDoc.Fields[2].Name = "excludeSubnets"
+	AddressFilterDoc.Fields[2].Type = "[]string"
+	AddressFilterDoc.Fields[2].Note = ""
+	AddressFilterDoc.Fields[2].Description = "Addresses are skipped if they belong to any of the subnets."
+	AddressFilterDoc.Fields[2].Comments[encoder.LineComment] = "Addresses are skipped if they belong to any of the subnets."
+	AddressFilterDoc.Fields[3].Name = "includeInterfaces"
+	AddressFilterDoc.Fields[3].Type = "[]string"
+	AddressFilterDoc.Fields[3].Note = ""
+	AddressFilterDoc.Fields[3].Description = "Addresses are kept only if they are assigned to any of the interfaces (if set)."
+	AddressFilterDoc.Fields[3].Comments[encoder.LineComment] = "Addresses are kept only if they are assigned to any of the interfaces (if set)."
+	AddressFilterDoc.Fields[4].Name = "excludeInterfaces"
+	AddressFilterDoc.Fields[4].Type = "[]string"
+	AddressFilterDoc.Fields[4].Note = ""
+	AddressFilterDoc.Fields[4].Description = "Addresses are skipped if they are assigned to any of the interfaces."
+	AddressFilterDoc.Fields[4].Comments[encoder.LineComment] = "Addresses are skipped if they are assigned to any of the interfaces."
 
 	ExtraHostDoc.Type = "ExtraHost"
 	ExtraHostDoc.Comments[encoder.LineComment] = "ExtraHost represents a host entry in /etc/hosts."
@@ -1831,6 +2112,98 @@ func init() {
 	FeaturesConfigDoc.Fields[0].Description = "Enable role-based access control (RBAC)."
 	FeaturesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable role-based access control (RBAC)."
 
+	ServiceResourcesConfigDoc.Type = "ServiceResourcesConfig"
+	ServiceResourcesConfigDoc.Comments[encoder.LineComment] = "ServiceResourcesConfig describes cgroup resource limits for a system service."
+	ServiceResourcesConfigDoc.Description = "ServiceResourcesConfig describes cgroup resource limits for a system service."
+
+	ServiceResourcesConfigDoc.AddExample("", machineServiceResourcesExample)
+	ServiceResourcesConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "serviceResources",
+		},
+	}
+	ServiceResourcesConfigDoc.Fields = make([]encoder.Doc, 2)
+	ServiceResourcesConfigDoc.Fields[0].Name = "memoryMax"
+	ServiceResourcesConfigDoc.Fields[0].Type = "uint64"
+	ServiceResourcesConfigDoc.Fields[0].Note = ""
+	ServiceResourcesConfigDoc.Fields[0].Description = "Maximum amount of memory the service can use, in bytes.\nZero value means no limit."
+	ServiceResourcesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Maximum amount of memory the service can use, in bytes."
+	ServiceResourcesConfigDoc.Fields[1].Name = "cpuWeight"
+	ServiceResourcesConfigDoc.Fields[1].Type = "uint64"
+	ServiceResourcesConfigDoc.Fields[1].Note = ""
+	ServiceResourcesConfigDoc.Fields[1].Description = "Relative CPU weight of the service in the range of 1-10000, default weight is 100.\nZero value means default weight."
+	ServiceResourcesConfigDoc.Fields[1].Comments[encoder.LineComment] = "Relative CPU weight of the service in the range of 1-10000, default weight is 100."
+
+	LogDestinationConfigDoc.Type = "LogDestinationConfig"
+	LogDestinationConfigDoc.Comments[encoder.LineComment] = "LogDestinationConfig represents a remote destination for the service logs."
+	LogDestinationConfigDoc.Description = "LogDestinationConfig represents a remote destination for the service logs."
+
+	LogDestinationConfigDoc.AddExample("", machineLogDestinationsExample)
+	LogDestinationConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "logDestinations",
+		},
+	}
+	LogDestinationConfigDoc.Fields = make([]encoder.Doc, 1)
+	LogDestinationConfigDoc.Fields[0].Name = "endpoint"
+	LogDestinationConfigDoc.Fields[0].Type = "Endpoint"
+	LogDestinationConfigDoc.Fields[0].Note = ""
+	LogDestinationConfigDoc.Fields[0].Description = "The address of the syslog server: `tcp://<host>:<port>` or `tls://<host>:<port>`.\n\nTLS server certificate is verified against the system CA bundle."
+	LogDestinationConfigDoc.Fields[0].Comments[encoder.LineComment] = "The address of the syslog server: `tcp://<host>:<port>` or `tls://<host>:<port>`."
+
+	APIRateLimitConfigDoc.Type = "APIRateLimitConfig"
+	APIRateLimitConfigDoc.Comments[encoder.LineComment] = "APIRateLimitConfig describes a rate limit for the machine API method."
+	APIRateLimitConfigDoc.Description = "APIRateLimitConfig describes a rate limit for the machine API method."
+
+	APIRateLimitConfigDoc.AddExample("", machineAPIRateLimitsExample)
+	APIRateLimitConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "apiRateLimits",
+		},
+	}
+	APIRateLimitConfigDoc.Fields = make([]encoder.Doc, 2)
+	APIRateLimitConfigDoc.Fields[0].Name = "rate"
+	APIRateLimitConfigDoc.Fields[0].Type = "float64"
+	APIRateLimitConfigDoc.Fields[0].Note = ""
+	APIRateLimitConfigDoc.Fields[0].Description = "Number of calls per second allowed on average.\nZero value means no limit."
+	APIRateLimitConfigDoc.Fields[0].Comments[encoder.LineComment] = "Number of calls per second allowed on average."
+	APIRateLimitConfigDoc.Fields[1].Name = "burst"
+	APIRateLimitConfigDoc.Fields[1].Type = "int"
+	APIRateLimitConfigDoc.Fields[1].Note = ""
+	APIRateLimitConfigDoc.Fields[1].Description = "Maximum number of calls allowed at once, defaults to 1."
+	APIRateLimitConfigDoc.Fields[1].Comments[encoder.LineComment] = "Maximum number of calls allowed at once, defaults to 1."
+
+	IMAConfigDoc.Type = "IMAConfig"
+	IMAConfigDoc.Comments[encoder.LineComment] = "IMAConfig represents the IMA policy configuration."
+	IMAConfigDoc.Description = "IMAConfig represents the IMA policy configuration."
+
+	IMAConfigDoc.AddExample("", machineIMAExample)
+	IMAConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "ima",
+		},
+	}
+	IMAConfigDoc.Fields = make([]encoder.Doc, 2)
+	IMAConfigDoc.Fields[0].Name = "profile"
+	IMAConfigDoc.Fields[0].Type = "string"
+	IMAConfigDoc.Fields[0].Note = ""
+	IMAConfigDoc.Fields[0].Description = "Built-in IMA policy profile.\n`default` measures executables, kernel modules, firmware and files read by root,\n`exec` measures only executables, kernel modules and firmware,\n`none` doesn't load any built-in rules."
+	IMAConfigDoc.Fields[0].Comments[encoder.LineComment] = "Built-in IMA policy profile."
+	IMAConfigDoc.Fields[0].Values = []string{
+		"default",
+		"exec",
+		"none",
+	}
+	IMAConfigDoc.Fields[1].Name = "rules"
+	IMAConfigDoc.Fields[1].Type = "[]string"
+	IMAConfigDoc.Fields[1].Note = ""
+	IMAConfigDoc.Fields[1].Description = "Additional IMA policy rules appended to the rules of the profile.\nRule syntax is described in the kernel [documentation](https://www.kernel.org/doc/Documentation/ABI/testing/ima_policy)."
+	IMAConfigDoc.Fields[1].Comments[encoder.LineComment] = "Additional IMA policy rules appended to the rules of the profile."
+
 	VolumeMountConfigDoc.Type = "VolumeMountConfig"
 	VolumeMountConfigDoc.Comments[encoder.LineComment] = "VolumeMountConfig struct describes extra volume mount for the static pods."
 	VolumeMountConfigDoc.Description = "VolumeMountConfig struct describes extra volume mount for the static pods."
@@ -1903,6 +2276,10 @@ func (_ ClusterConfig) Doc() *encoder.Doc {
 	return &ClusterConfigDoc
 }
 
+func (_ StaticPodWatchdogConfig) Doc() *encoder.Doc {
+	return &StaticPodWatchdogConfigDoc
+}
+
 func (_ ExtraMount) Doc() *encoder.Doc {
 	return &ExtraMountDoc
 }
@@ -1911,6 +2288,14 @@ func (_ KubeletConfig) Doc() *encoder.Doc {
 	return &KubeletConfigDoc
 }
 
+func (_ KubeletNodeIPConfig) Doc() *encoder.Doc {
+	return &KubeletNodeIPConfigDoc
+}
+
+func (_ KubeletReservedConfig) Doc() *encoder.Doc {
+	return &KubeletReservedConfigDoc
+}
+
 func (_ NetworkConfig) Doc() *encoder.Doc {
 	return &NetworkConfigDoc
 }
@@ -2015,6 +2400,10 @@ func (_ MachineFile) Doc() *encoder.Doc {
 	return &MachineFileDoc
 }
 
+func (_ AddressFilter) Doc() *encoder.Doc {
+	return &AddressFilterDoc
+}
+
 func (_ ExtraHost) Doc() *encoder.Doc {
 	return &ExtraHostDoc
 }
@@ -2075,6 +2464,22 @@ func (_ FeaturesConfig) Doc() *encoder.Doc {
 	return &FeaturesConfigDoc
 }
 
+func (_ ServiceResourcesConfig) Doc() *encoder.Doc {
+	return &ServiceResourcesConfigDoc
+}
+
+func (_ LogDestinationConfig) Doc() *encoder.Doc {
+	return &LogDestinationConfigDoc
+}
+
+func (_ APIRateLimitConfig) Doc() *encoder.Doc {
+	return &APIRateLimitConfigDoc
+}
+
+func (_ IMAConfig) Doc() *encoder.Doc {
+	return &IMAConfigDoc
+}
+
 func (_ VolumeMountConfig) Doc() *encoder.Doc {
 	return &VolumeMountConfigDoc
 }
@@ -2092,8 +2497,11 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ConfigDoc,
 			&MachineConfigDoc,
 			&ClusterConfigDoc,
+			&StaticPodWatchdogConfigDoc,
 			&ExtraMountDoc,
 			&KubeletConfigDoc,
+			&KubeletNodeIPConfigDoc,
+			&KubeletReservedConfigDoc,
 			&NetworkConfigDoc,
 			&InstallConfigDoc,
 			&InstallDiskSizeMatcherDoc,
@@ -2120,6 +2528,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&EncryptionKeyStaticDoc,
 			&EncryptionKeyNodeIDDoc,
 			&MachineFileDoc,
+			&AddressFilterDoc,
 			&ExtraHostDoc,
 			&DeviceDoc,
 			&DHCPOptionsDoc,
@@ -2135,6 +2544,10 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&RegistryTLSConfigDoc,
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
+			&ServiceResourcesConfigDoc,
+			&LogDestinationConfigDoc,
+			&APIRateLimitConfigDoc,
+			&IMAConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
 		},
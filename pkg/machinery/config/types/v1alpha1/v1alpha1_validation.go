@@ -10,8 +10,12 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 
 	valid "github.com/asaskevich/govalidator"
 	"github.com/hashicorp/go-multierror"
@@ -52,8 +56,23 @@ var (
 	ErrBadAddressing = errors.New("invalid network device addressing method")
 	// ErrInvalidAddress denotes that a bad address was provided.
 	ErrInvalidAddress = errors.New("invalid network address")
+
+	// Disks.
+
+	// ErrUnsupportedFilesystem denotes that the specified partition filesystem is not supported.
+	ErrUnsupportedFilesystem = errors.New("unsupported filesystem")
+	// ErrUnsupportedWipeMode denotes that the specified installation disk wipe mode is not supported.
+	ErrUnsupportedWipeMode = errors.New("unsupported wipe mode")
 )
 
+// serviceResourcesSupported is a list of system services which support cgroup resource limits.
+var serviceResourcesSupported = map[string]bool{
+	"containerd": true,
+	"cri":        true,
+	"etcd":       true,
+	"kubelet":    true,
+}
+
 // NetworkDeviceCheck defines the function type for checks.
 type NetworkDeviceCheck func(*Device, map[string]string) error
 
@@ -113,6 +132,10 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		warnings = append(warnings, warn...)
 		result = multierror.Append(result, err)
 
+		warn, err = ValidateCertSANSubnets(c.Cluster())
+		warnings = append(warnings, warn...)
+		result = multierror.Append(result, err)
+
 	case machine.TypeWorker:
 		for _, d := range c.Machine().Network().Devices() {
 			if d.VIPConfig() != nil {
@@ -144,18 +167,219 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 				result = multierror.Append(result, err)
 			}
 		}
+
+		filterNames := map[string]struct{}{}
+
+		for _, filter := range c.MachineConfig.MachineNetwork.NetworkAddressFilters {
+			if filter.FilterName == "" {
+				result = multierror.Append(result, fmt.Errorf("[%s] address filter name is required", "machine.network.addressFilters[].name"))
+			}
+
+			switch filter.FilterName {
+			case "no-k8s", "only-k8s":
+				// managed by Talos for Kubernetes
+				result = multierror.Append(result, fmt.Errorf("[%s] address filter name %q is reserved", "machine.network.addressFilters[].name", filter.FilterName))
+			}
+
+			if _, exists := filterNames[filter.FilterName]; exists {
+				result = multierror.Append(result, fmt.Errorf("[%s] duplicate address filter name %q", "machine.network.addressFilters[].name", filter.FilterName))
+			}
+
+			filterNames[filter.FilterName] = struct{}{}
+
+			for _, cidr := range append(append([]string(nil), filter.FilterIncludeSubnets...), filter.FilterExcludeSubnets...) {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.network.addressFilters[].subnets", cidr, ErrInvalidAddress))
+				}
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineInstall != nil {
+		switch c.MachineConfig.MachineInstall.InstallWipeMode {
+		case "", "quick", "discard", "zero":
+		default:
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.install.wipeMode", c.MachineConfig.MachineInstall.InstallWipeMode, ErrUnsupportedWipeMode))
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
 		for _, disk := range c.MachineConfig.MachineDisks {
+			var (
+				percent, freePercent float64
+				other                int
+			)
+
 			for i, pt := range disk.DiskPartitions {
-				if pt.DiskSize == 0 && i != len(disk.DiskPartitions)-1 {
+				if pt.DiskSize == 0 && pt.DiskRelativeSize == "" && i != len(disk.DiskPartitions)-1 {
 					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
 				}
+
+				switch pt.Filesystem() {
+				case constants.DefaultDiskFilesystem:
+				default:
+					result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.disks[].partitions[].filesystem", pt.DiskFilesystem, ErrUnsupportedFilesystem))
+				}
+
+				if pt.DiskRelativeSize == "" {
+					other++
+
+					continue
+				}
+
+				if pt.DiskSize != 0 {
+					result = multierror.Append(result, fmt.Errorf("partition for disk %q has both size and relativeSize set", disk.Device()))
+				}
+
+				partPercent, free, err := pt.DiskRelativeSize.Parse()
+				if err != nil {
+					result = multierror.Append(result, fmt.Errorf("[%s] %w", "machine.disks[].partitions[].relativeSize", err))
+
+					continue
+				}
+
+				if free {
+					freePercent += partPercent
+					other++
+				} else {
+					percent += partPercent
+				}
+			}
+
+			// the percentages of the disk size should leave space for the rest of the partitions
+			if percent > 100 || freePercent > 100 || (percent >= 100 && other > 0) {
+				result = multierror.Append(result, fmt.Errorf("partitions for disk %q exceed 100%% of the disk size", disk.Device()))
 			}
 		}
 	}
 
+	if c.MachineConfig.MachineTime != nil {
+		for _, server := range c.MachineConfig.MachineTime.TimeServers {
+			if net.ParseIP(server) == nil && !valid.IsDNSName(server) {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.time.servers", server, ErrInvalidAddress))
+			}
+		}
+	}
+
+	if c.MachineConfig.MachineKubelet != nil {
+		for _, cidr := range c.MachineConfig.MachineKubelet.KubeletNodeIP.KubeletNodeIPValidSubnets {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.kubelet.nodeIP.validSubnets", cidr, err))
+			}
+		}
+
+		switch c.MachineConfig.MachineKubelet.KubeletReserved.KubeletReservedPolicy {
+		case "", constants.KubeletReservedPolicyNone, constants.KubeletReservedPolicyAuto:
+		default:
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: unsupported policy", "machine.kubelet.reserved.policy", c.MachineConfig.MachineKubelet.KubeletReserved.KubeletReservedPolicy))
+		}
+	}
+
+	services := make([]string, 0, len(c.MachineConfig.MachineServiceResources))
+	for service := range c.MachineConfig.MachineServiceResources {
+		services = append(services, service)
+	}
+
+	sort.Strings(services)
+
+	for _, service := range services {
+		if !serviceResourcesSupported[service] {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: resource limits are not supported for the service", "machine.serviceResources", service))
+
+			continue
+		}
+
+		resources := c.MachineConfig.MachineServiceResources[service]
+		if resources != nil && resources.ServiceCPUWeight > 10000 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: cpu weight %d is out of range 1-10000", "machine.serviceResources", service, resources.ServiceCPUWeight))
+		}
+	}
+
+	if ima := c.MachineConfig.MachineIMA; ima != nil {
+		if err := ima.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", "machine.ima", err))
+		}
+	}
+
+	for _, f := range c.MachineConfig.MachineFiles {
+		if err := f.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.files", f.FilePath, err))
+		}
+	}
+
+	for _, pattern := range c.MachineConfig.MachineRedactPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.redactPatterns", pattern, err))
+		}
+	}
+
+	if c.MachineConfig.MachineFileLimit != 0 && c.MachineConfig.MachineFileLimit < constants.MinFileLimit {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: file limit should be at least %d", "machine.fileLimit", c.MachineConfig.MachineFileLimit, constants.MinFileLimit))
+	}
+
+	if size := c.MachineConfig.MachineServiceLogSize; size != 0 && (size < constants.MinServiceLogSize || size > constants.MaxServiceLogSize) {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: service log size should be between %d and %d", "machine.serviceLogSize", size, constants.MinServiceLogSize, constants.MaxServiceLogSize))
+	}
+
+	for _, d := range c.MachineConfig.MachineLogDestinations {
+		if err := d.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %w", "machine.logDestinations", err))
+		}
+	}
+
+	for _, cidr := range c.MachineConfig.MachineAPIListenSubnets {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.apiListenSubnets", cidr, err))
+		}
+	}
+
+	methods := make([]string, 0, len(c.MachineConfig.MachineAPIRateLimits))
+	for method := range c.MachineConfig.MachineAPIRateLimits {
+		methods = append(methods, method)
+	}
+
+	sort.Strings(methods)
+
+	for _, method := range methods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: method should be in the form /<service>/<method>", "machine.apiRateLimits", method))
+		}
+
+		if limit := c.MachineConfig.MachineAPIRateLimits[method]; limit != nil && (limit.APIRateLimitRate < 0 || limit.APIRateLimitBurst < 0) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: rate and burst should not be negative", "machine.apiRateLimits", method))
+		}
+	}
+
+	if c.MachineConfig.MachineMetricsListenAddress != "" {
+		if _, _, err := net.SplitHostPort(c.MachineConfig.MachineMetricsListenAddress); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.metricsListenAddress", c.MachineConfig.MachineMetricsListenAddress, err))
+		}
+	}
+
+	if c.MachineConfig.MachineReadinessListenAddress != "" {
+		if _, _, err := net.SplitHostPort(c.MachineConfig.MachineReadinessListenAddress); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "machine.readinessListenAddress", c.MachineConfig.MachineReadinessListenAddress, err))
+		}
+	}
+
+	for i, ca := range c.MachineConfig.MachineAcceptedCAs {
+		if ca == nil {
+			result = multierror.Append(result, fmt.Errorf("accepted CA %d is empty", i))
+
+			continue
+		}
+
+		if _, err := ca.GetCert(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("accepted CA %d is invalid: %w", i, err))
+		}
+	}
+
+	switch c.MachineConfig.MachineServiceLogFormat {
+	case "", constants.ServiceLogFormatText, constants.ServiceLogFormatJSON:
+	default:
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: unsupported service log format", "machine.serviceLogFormat", c.MachineConfig.MachineServiceLogFormat))
+	}
+
 	for _, label := range []string{constants.EphemeralPartitionLabel, constants.StatePartitionLabel} {
 		encryptionConfig := c.MachineConfig.SystemDiskEncryption().Get(label)
 		if encryptionConfig != nil {
@@ -209,15 +433,100 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, fmt.Errorf("%q is not a valid DNS name", c.ClusterNetwork.DNSDomain))
 	}
 
+	for i, key := range c.ClusterServiceAccountAdditionalKeys {
+		if key == nil {
+			result = multierror.Append(result, fmt.Errorf("service account additional key %d is empty", i))
+
+			continue
+		}
+
+		if _, err := key.GetKey(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("service account additional key %d is invalid: %w", i, err))
+		}
+	}
+
 	if ecp := c.ExternalCloudProviderConfig; ecp != nil {
 		result = multierror.Append(result, ecp.Validate())
 	}
 
 	result = multierror.Append(result, c.ClusterInlineManifests.Validate())
 
+	if c.StaticPodWatchdogConfig != nil {
+		threshold := c.StaticPodWatchdogConfig.WatchdogUnhealthyThreshold
+
+		if threshold != 0 && threshold < constants.StaticPodWatchdogMinUnhealthyThreshold {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: should be at least %s", "cluster.staticPodWatchdog.unhealthyThreshold", threshold, constants.StaticPodWatchdogMinUnhealthyThreshold))
+		}
+	}
+
 	return result.ErrorOrNil()
 }
 
+// ValidateCertSANSubnets checks API server IPs and certificate SAN IPs against pod and service subnets.
+//
+// API server IPs are allowed to be in the service subnet (they are allocated from it),
+// but any other certificate SAN IP overlapping pod or service subnet is reported as a warning.
+func ValidateCertSANSubnets(cluster config.ClusterConfig) ([]string, error) {
+	var warnings []string
+
+	podCIDRs, err := talosnet.SplitCIDRs(cluster.Network().PodCIDR())
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %q: %w", "cluster.network.podSubnets", cluster.Network().PodCIDR(), err)
+	}
+
+	serviceCIDRs, err := talosnet.SplitCIDRs(cluster.Network().ServiceCIDR())
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %q: %w", "cluster.network.serviceSubnets", cluster.Network().ServiceCIDR(), err)
+	}
+
+	apiServerIPs, err := cluster.Network().APIServerIPs()
+	if err != nil {
+		return nil, err
+	}
+
+	findSubnet := func(ip net.IP, cidrs []*net.IPNet) *net.IPNet {
+		for _, cidr := range cidrs {
+			if cidr.Contains(ip) {
+				return cidr
+			}
+		}
+
+		return nil
+	}
+
+	for _, ip := range apiServerIPs {
+		if subnet := findSubnet(ip, podCIDRs); subnet != nil {
+			warnings = append(warnings, fmt.Sprintf("API server IP %s overlaps with pod subnet %s", ip, subnet))
+		}
+	}
+
+sanLoop:
+	for _, san := range cluster.CertSANs() {
+		ip := net.ParseIP(san)
+		if ip == nil {
+			continue
+		}
+
+		if subnet := findSubnet(ip, podCIDRs); subnet != nil {
+			warnings = append(warnings, fmt.Sprintf("certificate SAN IP %s overlaps with pod subnet %s", ip, subnet))
+
+			continue
+		}
+
+		for _, apiServerIP := range apiServerIPs {
+			if apiServerIP.Equal(ip) {
+				continue sanLoop
+			}
+		}
+
+		if subnet := findSubnet(ip, serviceCIDRs); subnet != nil {
+			warnings = append(warnings, fmt.Sprintf("certificate SAN IP %s overlaps with service subnet %s", ip, subnet))
+		}
+	}
+
+	return warnings, nil
+}
+
 // ValidateCNI validates CNI config.
 func ValidateCNI(cni config.CNI) ([]string, error) {
 	var (
@@ -272,6 +581,160 @@ func (ecp *ExternalCloudProviderConfig) Validate() error {
 	return result.ErrorOrNil()
 }
 
+// imaActions is a list of IMA policy rule actions.
+var imaActions = map[string]bool{
+	"measure":       true,
+	"dont_measure":  true,
+	"appraise":      true,
+	"dont_appraise": true,
+	"audit":         true,
+	"hash":          true,
+	"dont_hash":     true,
+}
+
+// imaConditions is a list of IMA policy rule conditions and options.
+var imaConditions = map[string]bool{
+	"func":            true,
+	"mask":            true,
+	"fsmagic":         true,
+	"fsuuid":          true,
+	"fsname":          true,
+	"uid":             true,
+	"euid":            true,
+	"gid":             true,
+	"egid":            true,
+	"fowner":          true,
+	"fgroup":          true,
+	"subj_user":       true,
+	"subj_role":       true,
+	"subj_type":       true,
+	"obj_user":        true,
+	"obj_role":        true,
+	"obj_type":        true,
+	"appraise_type":   true,
+	"appraise_flag":   true,
+	"permit_directio": true,
+	"pcr":             true,
+	"template":        true,
+	"keyrings":        true,
+	"label":           true,
+}
+
+// Validate validates the log destination.
+func (d *LogDestinationConfig) Validate() error {
+	if d.LogEndpoint == nil || d.LogEndpoint.URL == nil {
+		return fmt.Errorf("endpoint is required")
+	}
+
+	switch d.LogEndpoint.Scheme {
+	case "tcp", "tls":
+	default:
+		return fmt.Errorf("%q: unsupported scheme %q", d.LogEndpoint.String(), d.LogEndpoint.Scheme)
+	}
+
+	if d.LogEndpoint.Hostname() == "" || d.LogEndpoint.Port() == "" {
+		return fmt.Errorf("%q: endpoint should be in the host:port form", d.LogEndpoint.String())
+	}
+
+	return nil
+}
+
+// Validate validates IMA policy configuration.
+//
+// Rules are only checked for basic syntax, the kernel performs complete validation when the policy is loaded.
+func (i *IMAConfig) Validate() error {
+	var result *multierror.Error
+
+	switch i.IMAProfile {
+	case "", constants.IMAProfileDefault, constants.IMAProfileExec, constants.IMAProfileNone:
+	default:
+		result = multierror.Append(result, fmt.Errorf("unsupported profile %q", i.IMAProfile))
+	}
+
+	for _, rule := range i.IMARules {
+		if err := validateIMARule(rule); err != nil {
+			result = multierror.Append(result, fmt.Errorf("rule %q is invalid: %w", rule, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateIMARule(rule string) error {
+	fields := strings.Fields(rule)
+	if len(fields) == 0 {
+		return fmt.Errorf("rule is empty")
+	}
+
+	if !imaActions[fields[0]] {
+		return fmt.Errorf("unknown action %q", fields[0])
+	}
+
+	for _, field := range fields[1:] {
+		key := field
+
+		if idx := strings.IndexAny(field, "=<>"); idx != -1 {
+			key = field[:idx]
+
+			if idx == len(field)-1 {
+				return fmt.Errorf("condition %q has no value", key)
+			}
+		} else if key != "permit_directio" {
+			return fmt.Errorf("condition %q has no value", key)
+		}
+
+		if !imaConditions[key] {
+			return fmt.Errorf("unknown condition %q", key)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the file path and operation.
+func (f *MachineFile) Validate() error {
+	if !filepath.IsAbs(f.FilePath) {
+		return fmt.Errorf("path should be absolute")
+	}
+
+	if filepath.Clean(f.FilePath) != f.FilePath {
+		return fmt.Errorf("path should be clean, expected %q", filepath.Clean(f.FilePath))
+	}
+
+	if os.FileMode(f.FilePermissions)&^os.ModePerm != 0 {
+		return fmt.Errorf("invalid permissions %s", f.FilePermissions)
+	}
+
+	switch f.FileOp {
+	case "create":
+		if !isWritablePath(f.FilePath) {
+			return fmt.Errorf("create operation is only allowed under %s", strings.Join(append([]string{"/var"}, constants.Overlays...), ", "))
+		}
+	case "append", "overwrite":
+	default:
+		return fmt.Errorf("unknown operation %q", f.FileOp)
+	}
+
+	if f.FileTemplate {
+		if _, err := template.New(f.FilePath).Parse(f.FileContent); err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isWritablePath checks whether the file can be created at the path on the read-only rootfs.
+func isWritablePath(path string) bool {
+	for _, dir := range append([]string{"/var"}, constants.Overlays...) {
+		if strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validate the inline manifests.
 func (manifests ClusterInlineManifests) Validate() error {
 	var result *multierror.Error
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
@@ -293,6 +295,672 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "2 errors occurred:\n\t* inline manifest name can't be empty\n\t* inline manifest name \"foo\" is duplicate\n\n",
 		},
+		{
+			name: "CertSANsOverlapSubnets",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						CertSANs: []string{
+							"example.com",
+							"172.20.0.1",
+							"10.96.0.1",
+							"10.96.0.10",
+							"10.244.1.5",
+						},
+					},
+					ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+						DNSDomain:     "cluster.local",
+						PodSubnet:     []string{"10.244.0.0/16"},
+						ServiceSubnet: []string{"10.96.0.0/12"},
+					},
+				},
+			},
+			expectedWarnings: []string{
+				"certificate SAN IP 10.96.0.10 overlaps with service subnet 10.96.0.0/12",
+				"certificate SAN IP 10.244.1.5 overlaps with pod subnet 10.244.0.0/16",
+			},
+		},
+		{
+			name: "ServiceAccountAdditionalKeysInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterServiceAccountAdditionalKeys: []*x509.PEMEncodedKey{
+						{
+							Key: []byte("foo"),
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* service account additional key 0 is invalid: failed to parse PEM block\n\n",
+		},
+		{
+			name: "StaticPodWatchdogNegativeThreshold",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodWatchdogConfig: &v1alpha1.StaticPodWatchdogConfig{
+						WatchdogUnhealthyThreshold: -time.Minute,
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [cluster.staticPodWatchdog.unhealthyThreshold] \"-1m0s\": should be at least 1m0s\n\n",
+		},
+		{
+			name: "StaticPodWatchdogShortThreshold",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodWatchdogConfig: &v1alpha1.StaticPodWatchdogConfig{
+						WatchdogUnhealthyThreshold: 5 * time.Nanosecond,
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [cluster.staticPodWatchdog.unhealthyThreshold] \"5ns\": should be at least 1m0s\n\n",
+		},
+		{
+			name: "AcceptedCAsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificateAndKey{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* accepted CA 0 is invalid: failed to parse PEM block\n\n",
+		},
+		{
+			name: "APIListenSubnetsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:             "controlplane",
+					MachineAPIListenSubnets: []string{"10.0.0.0/24", "10.0.1.1"},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.apiListenSubnets] \"10.0.1.1\": invalid CIDR address: 10.0.1.1\n\n",
+		},
+		{
+			name: "MetricsListenAddressInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:                 "controlplane",
+					MachineMetricsListenAddress: "9101",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.metricsListenAddress] \"9101\": address 9101: missing port in address\n\n",
+		},
+		{
+			name: "ReadinessListenAddressInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:                   "controlplane",
+					MachineReadinessListenAddress: "localhost",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.readinessListenAddress] \"localhost\": address localhost: missing port in address\n\n",
+		},
+		{
+			name: "DiskFilesystemUnsupported",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskMountPoint: "/var/mnt/extra",
+									DiskFilesystem: "ext4",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.disks[].partitions[].filesystem] \"ext4\": unsupported filesystem\n\n",
+		},
+		{
+			name: "InstallWipeModeUnsupported",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallWipe:     true,
+						InstallWipeMode: "shred",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.install.wipeMode] \"shred\": unsupported wipe mode\n\n",
+		},
+		{
+			name: "DiskRelativeSize",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskMountPoint: "/var/mnt/a",
+									DiskSize:       10 * 1024 * 1024 * 1024,
+								},
+								{
+									DiskMountPoint:   "/var/mnt/b",
+									DiskRelativeSize: "25%",
+								},
+								{
+									DiskMountPoint:   "/var/mnt/c",
+									DiskRelativeSize: "100%FREE",
+								},
+								{
+									DiskMountPoint:   "/var/mnt/d",
+									DiskRelativeSize: "25%",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "DiskRelativeSizeExceeds",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskMountPoint: "/var/mnt/a",
+									DiskSize:       10 * 1024 * 1024 * 1024,
+								},
+								{
+									DiskMountPoint:   "/var/mnt/b",
+									DiskRelativeSize: "100%",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* partitions for disk \"/dev/sdb\" exceed 100% of the disk size\n\n",
+		},
+		{
+			name: "DiskRelativeSizeInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskMountPoint:   "/var/mnt/a",
+									DiskSize:         10 * 1024 * 1024 * 1024,
+									DiskRelativeSize: "50",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* partition for disk \"/dev/sdb\" has both size and relativeSize set\n\t* [machine.disks[].partitions[].relativeSize] failed to parse relative disk size \"50\": missing %\n\n",
+		},
+		{
+			name: "APIRateLimitsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineAPIRateLimits: map[string]*v1alpha1.APIRateLimitConfig{
+						"/machine.MachineService/Stats": {
+							APIRateLimitRate: -1,
+						},
+						"Processes": {
+							APIRateLimitRate: 1,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [machine.apiRateLimits] \"/machine.MachineService/Stats\": rate and burst should not be negative\n\t* [machine.apiRateLimits] \"Processes\": method should be in the form /<service>/<method>\n\n",
+		},
+		{
+			name: "ServiceResourcesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineServiceResources: map[string]*v1alpha1.ServiceResourcesConfig{
+						"kubelet": {
+							ServiceMemoryMax: 512 * 1024 * 1024,
+							ServiceCPUWeight: 20000,
+						},
+						"apid": {
+							ServiceCPUWeight: 100,
+						},
+						"etcd": {
+							ServiceCPUWeight: 200,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [machine.serviceResources] \"apid\": resource limits are not supported for the service\n\t* [machine.serviceResources] \"kubelet\": cpu weight 20000 is out of range 1-10000\n\n",
+		},
+		{
+			name: "KubeletReservedPolicyInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletReserved: v1alpha1.KubeletReservedConfig{
+							KubeletReservedPolicy: "static",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.kubelet.reserved.policy] \"static\": unsupported policy\n\n",
+		},
+		{
+			name: "FileLimitTooLow",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:      "worker",
+					MachineFileLimit: 512,
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.fileLimit] 512: file limit should be at least 1024\n\n",
+		},
+		{
+			name: "ServiceLogSizeTooHigh",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:           "worker",
+					MachineServiceLogSize: 1 << 30,
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.serviceLogSize] 1073741824: service log size should be between 65536 and 67108864\n\n",
+		},
+		{
+			name: "ServiceLogFormatInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:             "worker",
+					MachineServiceLogFormat: "xml",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.serviceLogFormat] \"xml\": unsupported service log format\n\n",
+		},
+		{
+			name: "LogDestinationInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineLogDestinations: []*v1alpha1.LogDestinationConfig{
+						{
+							LogEndpoint: &v1alpha1.Endpoint{
+								URL: &url.URL{Scheme: "udp", Host: "1.2.3.4:514"},
+							},
+						},
+						{
+							LogEndpoint: &v1alpha1.Endpoint{
+								URL: &url.URL{Scheme: "tcp", Host: "1.2.3.4"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [machine.logDestinations] \"udp://1.2.3.4:514\": unsupported scheme \"udp\"\n\t* [machine.logDestinations] \"tcp://1.2.3.4\": endpoint should be in the host:port form\n\n",
+		},
+		{
+			name: "RedactPatternsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineRedactPatterns: []string{
+						`api_key=(\S+)`,
+						`password=(\S+`,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.redactPatterns] \"password=(\\\\S+\": error parsing regexp: missing closing ): `password=(\\S+`\n\n",
+		},
+		{
+			name: "IMAInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineIMA: &v1alpha1.IMAConfig{
+						IMAProfile: "strict",
+						IMARules: []string{
+							"measure func=BPRM_CHECK mask=MAY_EXEC",
+							"measure func=FILE_CHECK uid<1000",
+							"measured func=MODULE_CHECK",
+							"dont_measure fsmagic",
+							"appraise owner=0",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.ima]: 4 errors occurred:\n\t* unsupported profile \"strict\"\n\t* rule \"measured func=MODULE_CHECK\" is invalid: unknown action \"measured\"\n\t* rule \"dont_measure fsmagic\" is invalid: condition \"fsmagic\" has no value\n\t* rule \"appraise owner=0\" is invalid: unknown condition \"owner\"\n\n\n\n",
+		},
+		{
+			name: "MachineFilesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFiles: []*v1alpha1.MachineFile{
+						{
+							FileContent:     "foo",
+							FilePermissions: 0o644,
+							FilePath:        "/var/lib/foo.conf",
+							FileOp:          "create",
+						},
+						{
+							FileContent:     "bar",
+							FilePermissions: 0o644,
+							FilePath:        "/etc/kubernetes/bar.conf",
+							FileOp:          "create",
+						},
+						{
+							FileContent:     "ca",
+							FilePermissions: 0o644,
+							FilePath:        "/etc/ssl/certs/ca.crt",
+							FileOp:          "create",
+						},
+						{
+							FileContent:     "foo",
+							FilePermissions: 0o644,
+							FilePath:        "/var/../etc/foo",
+							FileOp:          "append",
+						},
+						{
+							FileContent:     "foo",
+							FilePermissions: 0o644,
+							FilePath:        "var/foo",
+							FileOp:          "overwrite",
+						},
+						{
+							FileContent:     "foo",
+							FilePermissions: 0o644,
+							FilePath:        "/var/foo",
+							FileOp:          "delete",
+						},
+						{
+							FileContent:     "node-ip: {{ .IP }}",
+							FilePermissions: 0o644,
+							FilePath:        "/var/lib/node.conf",
+							FileOp:          "create",
+							FileTemplate:    true,
+						},
+						{
+							FileContent:     "node-ip: {{ .IP ",
+							FilePermissions: 0o644,
+							FilePath:        "/var/lib/broken.conf",
+							FileOp:          "create",
+							FileTemplate:    true,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n" +
+				"\t* [machine.files] \"/etc/ssl/certs/ca.crt\": create operation is only allowed under /var, /etc/kubernetes, /etc/cni, /usr/libexec/kubernetes, /usr/etc/udev, /opt\n" +
+				"\t* [machine.files] \"/var/../etc/foo\": path should be clean, expected \"/etc/foo\"\n" +
+				"\t* [machine.files] \"var/foo\": path should be absolute\n" +
+				"\t* [machine.files] \"/var/foo\": unknown operation \"delete\"\n" +
+				"\t* [machine.files] \"/var/lib/broken.conf\": invalid template: template: /var/lib/broken.conf:1: unclosed action\n\n",
+		},
+		{
+			name: "AddressFilters",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkAddressFilters: []*v1alpha1.AddressFilter{
+							{
+								FilterName:              "internal",
+								FilterIncludeSubnets:    []string{"10.0.0.0/8"},
+								FilterExcludeInterfaces: []string{"kube-bridge"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AddressFiltersInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkAddressFilters: []*v1alpha1.AddressFilter{
+							{
+								FilterName:           "internal",
+								FilterExcludeSubnets: []string{"10.0.0.0"},
+							},
+							{
+								FilterName: "internal",
+							},
+							{
+								FilterName: "no-k8s",
+							},
+							{
+								FilterIncludeInterfaces: []string{"eth0"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n" +
+				"\t* [machine.network.addressFilters[].subnets] \"10.0.0.0\": invalid network address\n" +
+				"\t* [machine.network.addressFilters[].name] duplicate address filter name \"internal\"\n" +
+				"\t* [machine.network.addressFilters[].name] address filter name \"no-k8s\" is reserved\n" +
+				"\t* [machine.network.addressFilters[].name] address filter name is required\n\n",
+		},
 		{
 			name: "BondDefaultConfig",
 			config: &v1alpha1.Config{
@@ -486,6 +1154,59 @@ func TestValidate(t *testing.T) {
 			expectedError: "3 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
 				"\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
 		},
+		{
+			name: "TimeServers",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers: []string{
+							"time.cloudflare.com",
+							"10.5.0.1",
+							"2001:db8::1",
+							"pool.ntp.org:123",
+							"",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [machine.time.servers] \"pool.ntp.org:123\": invalid network address\n" +
+				"\t* [machine.time.servers] \"\": invalid network address\n\n",
+		},
+		{
+			name: "KubeletNodeIPValidSubnets",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletNodeIP: v1alpha1.KubeletNodeIPConfig{
+							KubeletNodeIPValidSubnets: []string{
+								"10.0.0.0/8",
+								"fd00::/8",
+								"10.0.0.1",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [machine.kubelet.nodeIP.validSubnets] \"10.0.0.1\": invalid CIDR address: 10.0.0.1\n\n",
+		},
 	} {
 		test := test
 
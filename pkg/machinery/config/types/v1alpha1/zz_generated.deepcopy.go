@@ -8,6 +8,26 @@
 
 package v1alpha1
 
+import (
+	x509 "github.com/talos-systems/crypto/x509"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimitConfig) DeepCopyInto(out *APIRateLimitConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimitConfig.
+func (in *APIRateLimitConfig) DeepCopy() *APIRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 	*out = *in
@@ -44,6 +64,10 @@ func (in *APIServerConfig) DeepCopy() *APIServerConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdminKubeconfigConfig) DeepCopyInto(out *AdminKubeconfigConfig) {
 	*out = *in
+	if in.AdminKubeconfigEndpoint != nil {
+		in, out := &in.AdminKubeconfigEndpoint, &out.AdminKubeconfigEndpoint
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -77,6 +101,42 @@ func (in Base64Bytes) DeepCopy() Base64Bytes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressFilter) DeepCopyInto(out *AddressFilter) {
+	*out = *in
+	if in.FilterIncludeSubnets != nil {
+		in, out := &in.FilterIncludeSubnets, &out.FilterIncludeSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterExcludeSubnets != nil {
+		in, out := &in.FilterExcludeSubnets, &out.FilterExcludeSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterIncludeInterfaces != nil {
+		in, out := &in.FilterIncludeInterfaces, &out.FilterIncludeInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterExcludeInterfaces != nil {
+		in, out := &in.FilterExcludeInterfaces, &out.FilterExcludeInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressFilter.
+func (in *AddressFilter) DeepCopy() *AddressFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bond) DeepCopyInto(out *Bond) {
 	*out = *in
@@ -154,6 +214,15 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		in, out := &in.ClusterServiceAccount, &out.ClusterServiceAccount
 		*out = (*in).DeepCopy()
 	}
+	if in.ClusterServiceAccountAdditionalKeys != nil {
+		in, out := &in.ClusterServiceAccountAdditionalKeys, &out.ClusterServiceAccountAdditionalKeys
+		*out = make([]*x509.PEMEncodedKey, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				(*out)[i] = (*in)[i].DeepCopy()
+			}
+		}
+	}
 	if in.APIServerConfig != nil {
 		in, out := &in.APIServerConfig, &out.APIServerConfig
 		*out = new(APIServerConfig)
@@ -211,6 +280,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(AdminKubeconfigConfig)
 		**out = **in
 	}
+	if in.StaticPodWatchdogConfig != nil {
+		in, out := &in.StaticPodWatchdogConfig, &out.StaticPodWatchdogConfig
+		*out = new(StaticPodWatchdogConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -720,6 +794,27 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IMAConfig) DeepCopyInto(out *IMAConfig) {
+	*out = *in
+	if in.IMARules != nil {
+		in, out := &in.IMARules, &out.IMARules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IMAConfig.
+func (in *IMAConfig) DeepCopy() *IMAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IMAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 	*out = *in
@@ -798,6 +893,8 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.KubeletNodeIP.DeepCopyInto(&out.KubeletNodeIP)
+	out.KubeletReserved = in.KubeletReserved
 	return
 }
 
@@ -811,6 +908,63 @@ func (in *KubeletConfig) DeepCopy() *KubeletConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletNodeIPConfig) DeepCopyInto(out *KubeletNodeIPConfig) {
+	*out = *in
+	if in.KubeletNodeIPValidSubnets != nil {
+		in, out := &in.KubeletNodeIPValidSubnets, &out.KubeletNodeIPValidSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletNodeIPConfig.
+func (in *KubeletNodeIPConfig) DeepCopy() *KubeletNodeIPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletNodeIPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletReservedConfig) DeepCopyInto(out *KubeletReservedConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletReservedConfig.
+func (in *KubeletReservedConfig) DeepCopy() *KubeletReservedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletReservedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDestinationConfig) DeepCopyInto(out *LogDestinationConfig) {
+	*out = *in
+	if in.LogEndpoint != nil {
+		in, out := &in.LogEndpoint, &out.LogEndpoint
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDestinationConfig.
+func (in *LogDestinationConfig) DeepCopy() *LogDestinationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDestinationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -890,6 +1044,71 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 		*out = new(FeaturesConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MachineServiceResources != nil {
+		in, out := &in.MachineServiceResources, &out.MachineServiceResources
+		*out = make(map[string]*ServiceResourcesConfig, len(*in))
+		for key, val := range *in {
+			var outVal *ServiceResourcesConfig
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(ServiceResourcesConfig)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.MachineIMA != nil {
+		in, out := &in.MachineIMA, &out.MachineIMA
+		*out = new(IMAConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineRedactPatterns != nil {
+		in, out := &in.MachineRedactPatterns, &out.MachineRedactPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineLogDestinations != nil {
+		in, out := &in.MachineLogDestinations, &out.MachineLogDestinations
+		*out = make([]*LogDestinationConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LogDestinationConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MachineAcceptedCAs != nil {
+		in, out := &in.MachineAcceptedCAs, &out.MachineAcceptedCAs
+		*out = make([]*x509.PEMEncodedCertificateAndKey, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				(*out)[i] = (*in)[i].DeepCopy()
+			}
+		}
+	}
+	if in.MachineAPIListenSubnets != nil {
+		in, out := &in.MachineAPIListenSubnets, &out.MachineAPIListenSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineAPIRateLimits != nil {
+		in, out := &in.MachineAPIRateLimits, &out.MachineAPIRateLimits
+		*out = make(map[string]*APIRateLimitConfig, len(*in))
+		for key, val := range *in {
+			var outVal *APIRateLimitConfig
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(APIRateLimitConfig)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -976,6 +1195,17 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 			}
 		}
 	}
+	if in.NetworkAddressFilters != nil {
+		in, out := &in.NetworkAddressFilters, &out.NetworkAddressFilters
+		*out = make([]*AddressFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AddressFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -1206,6 +1436,38 @@ func (in *SchedulerConfig) DeepCopy() *SchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceResourcesConfig) DeepCopyInto(out *ServiceResourcesConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceResourcesConfig.
+func (in *ServiceResourcesConfig) DeepCopy() *ServiceResourcesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceResourcesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPodWatchdogConfig) DeepCopyInto(out *StaticPodWatchdogConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPodWatchdogConfig.
+func (in *StaticPodWatchdogConfig) DeepCopy() *StaticPodWatchdogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPodWatchdogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemDiskEncryptionConfig) DeepCopyInto(out *SystemDiskEncryptionConfig) {
 	*out = *in
@@ -62,6 +62,18 @@ const (
 	// KernelParamNetworkInterfaceIgnore is the kernel parameter for specifying network interfaces which should be ignored by talos.
 	KernelParamNetworkInterfaceIgnore = "talos.network.interface.ignore"
 
+	// KernelParamDownloadRateLimit is the kernel parameter name for specifying the download speed limit (bytes per second)
+	// for the machine config.
+	KernelParamDownloadRateLimit = "talos.download.ratelimit"
+
+	// KernelParamDownloadProxy is the kernel parameter name for specifying the HTTP(S) proxy URL
+	// for the machine config download.
+	KernelParamDownloadProxy = "talos.download.proxy"
+
+	// KernelParamDownloadNoProxy is the kernel parameter name for specifying the comma-separated list of hosts
+	// (domains, IPs or CIDRs) which should be reached without the proxy set by KernelParamDownloadProxy.
+	KernelParamDownloadNoProxy = "talos.download.noproxy"
+
 	// KernelParamPanic is the kernel parameter name for specifying the time to wait until rebooting after kernel panic (0 disables reboot).
 	KernelParamPanic = "panic"
 
@@ -117,6 +129,9 @@ const (
 	// the root path.
 	RootMountPoint = "/"
 
+	// DefaultDiskFilesystem is the filesystem user disk partitions are formatted with by default.
+	DefaultDiskFilesystem = "xfs"
+
 	// ISOFilesystemLabel is the label of the ISO file system for the Talos
 	// installer.
 	ISOFilesystemLabel = "TALOS"
@@ -181,6 +196,12 @@ const (
 	// KubernetesAdminCertDefaultLifetime defines default lifetime for Kubernetes generated admin certificate.
 	KubernetesAdminCertDefaultLifetime = 365 * 24 * time.Hour
 
+	// StaticPodWatchdogDefaultUnhealthyThreshold defines default time a control plane static pod should stay unhealthy before it is restarted.
+	StaticPodWatchdogDefaultUnhealthyThreshold = 5 * time.Minute
+
+	// StaticPodWatchdogMinUnhealthyThreshold defines minimum supported time a control plane static pod should stay unhealthy before it is restarted.
+	StaticPodWatchdogMinUnhealthyThreshold = time.Minute
+
 	// KubebernetesStaticSecretsDir defines ephemeral directory which contains rendered secrets for controlplane components.
 	KubebernetesStaticSecretsDir = "/system/secrets/kubernetes"
 
@@ -206,6 +227,9 @@ const (
 	// KubeletPKIDir is the path to the directory where kubelet stores issued certificates and keys.
 	KubeletPKIDir = "/var/lib/kubelet/pki"
 
+	// KubeletPodsDir is the path to the directory where kubelet stores pod volumes.
+	KubeletPodsDir = "/var/lib/kubelet/pods"
+
 	// SystemKubeletPKIDir is the path to the directory where Talos copies kubelet issued certificates and keys.
 	SystemKubeletPKIDir = "/system/secrets/kubelet"
 
@@ -254,6 +278,42 @@ const (
 	// KubeletKubeconfig is the generated kubeconfig for kubelet.
 	KubeletKubeconfig = "/etc/kubernetes/kubeconfig-kubelet"
 
+	// DefaultFileLimit is the default maximum number of open file descriptors.
+	DefaultFileLimit = 1048576
+
+	// MinFileLimit is the minimum supported maximum number of open file descriptors.
+	MinFileLimit = 1024
+
+	// DefaultServiceLogSize is the default maximum size of the in-memory service log.
+	DefaultServiceLogSize = 1048576
+
+	// MinServiceLogSize is the minimum supported size of the in-memory service log.
+	MinServiceLogSize = 65536
+
+	// MaxServiceLogSize is the maximum supported size of the in-memory service log.
+	MaxServiceLogSize = 67108864
+
+	// ServiceLogFormatText is the plain text service log format.
+	ServiceLogFormatText = "text"
+
+	// ServiceLogFormatJSON is the JSON service log format.
+	ServiceLogFormatJSON = "json"
+
+	// IMAProfileDefault is the built-in IMA policy which measures executables, kernel modules, firmware and files read by root.
+	IMAProfileDefault = "default"
+
+	// IMAProfileExec is the built-in IMA policy which measures executables, kernel modules and firmware.
+	IMAProfileExec = "exec"
+
+	// IMAProfileNone disables built-in IMA policy rules.
+	IMAProfileNone = "none"
+
+	// KubeletReservedPolicyNone disables reserving resources for the system and Kubernetes daemons.
+	KubeletReservedPolicyNone = "none"
+
+	// KubeletReservedPolicyAuto computes resources reserved for the system and Kubernetes daemons based on the node capacity.
+	KubeletReservedPolicyAuto = "auto"
+
 	// DefaultEtcdVersion is the default target version of etcd.
 	DefaultEtcdVersion = "v3.4.16"
 
@@ -266,6 +326,12 @@ const (
 	// EtcdTalosManifestApplyMutex is the etcd election .
 	EtcdTalosManifestApplyMutex = EtcdRootTalosKey + ":manifestApplyMutex"
 
+	// EtcdTalosMaintenanceMutex is the etcd mutex prefix to be used to serialize etcd maintenance (defragmentation, compaction).
+	EtcdTalosMaintenanceMutex = EtcdRootTalosKey + ":maintenanceMutex"
+
+	// EtcdCompactDefaultRetainRevisions is the default number of the most recent revisions kept on etcd history compaction.
+	EtcdCompactDefaultRetainRevisions = 10000
+
 	// EtcdImage is the reposistory for the etcd image.
 	EtcdImage = "gcr.io/etcd-development/etcd"
 
@@ -422,6 +488,11 @@ const (
 	// For bootstrap API, this includes time to run bootstrap.
 	NodeReadyTimeout = BootTimeout
 
+	// GRPCGracefulShutdownTimeout is the timeout for the in-flight API calls to complete on shutdown.
+	//
+	// It should be less than the graceful shutdown timeout of the service runner.
+	GRPCGracefulShutdownTimeout = 5 * time.Second
+
 	// AnnotationCordonedKey is the annotation key for the nodes cordoned by Talos.
 	AnnotationCordonedKey = "talos.dev/cordoned"
 
@@ -447,6 +518,15 @@ const (
 	DefaultSecondaryResolver = "8.8.8.8"
 )
 
+// Overlays is the list of rootfs directories mounted as writable overlays.
+var Overlays = []string{
+	"/etc/kubernetes",
+	"/etc/cni",
+	"/usr/libexec/kubernetes",
+	"/usr/etc/udev",
+	"/opt",
+}
+
 // See https://linux.die.net/man/3/klogctl
 //nolint:stylecheck,revive
 const (
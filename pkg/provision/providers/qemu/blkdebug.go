@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/talos-systems/talos/pkg/provision"
+	"github.com/talos-systems/talos/pkg/provision/providers/vm"
+)
+
+// blkdebugWriteErrorsConfig makes every write to the disk fail with EIO.
+const blkdebugWriteErrorsConfig = `[inject-error]
+iotype = "write"
+errno = "5"
+`
+
+// createBlkdebugConfigs writes blkdebug configuration for disks with error injection enabled.
+//
+// Returned slice is indexed by disk number, empty path means no error injection.
+func (p *provisioner) createBlkdebugConfigs(state *vm.State, nodeReq provision.NodeRequest) ([]string, error) {
+	paths := make([]string, len(nodeReq.Disks))
+
+	for i, disk := range nodeReq.Disks {
+		if !disk.WriteErrors {
+			continue
+		}
+
+		path := state.GetRelativePath(fmt.Sprintf("%s-%d.blkdebug", nodeReq.Name, i))
+
+		if err := ioutil.WriteFile(path, []byte(blkdebugWriteErrorsConfig), 0o644); err != nil {
+			return nil, err
+		}
+
+		paths[i] = path
+	}
+
+	return paths, nil
+}
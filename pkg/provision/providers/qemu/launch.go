@@ -34,6 +34,7 @@ type LaunchConfig struct {
 
 	// VM options
 	DiskPaths         []string
+	DiskBlkdebugPaths []string
 	VCPUCount         int64
 	MemSize           int64
 	QemuExecutable    string
@@ -251,7 +252,12 @@ func launchVM(config *LaunchConfig) error {
 		"-smbios", fmt.Sprintf("type=1,uuid=%s", config.NodeUUID),
 	}
 
-	for _, disk := range config.DiskPaths {
+	for i, disk := range config.DiskPaths {
+		if i < len(config.DiskBlkdebugPaths) && config.DiskBlkdebugPaths[i] != "" {
+			// route disk I/O through blkdebug driver to inject errors
+			disk = fmt.Sprintf("blkdebug:%s:%s", config.DiskBlkdebugPaths[i], disk)
+		}
+
 		args = append(args, "-drive", fmt.Sprintf("format=raw,if=virtio,file=%s", disk))
 	}
 
@@ -60,6 +60,11 @@ func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRe
 		return provision.NodeInfo{}, err
 	}
 
+	diskBlkdebugPaths, err := p.createBlkdebugConfigs(state, nodeReq)
+	if err != nil {
+		return provision.NodeInfo{}, fmt.Errorf("error creating blkdebug configs: %w", err)
+	}
+
 	logFile, err := os.OpenFile(state.GetRelativePath(fmt.Sprintf("%s.log", nodeReq.Name)), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o666)
 	if err != nil {
 		return provision.NodeInfo{}, err
@@ -87,9 +92,13 @@ func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRe
 	if !nodeReq.SkipInjectingConfig {
 		cmdline.Append("talos.config", "{TALOS_CONFIG_URL}") // to be patched by launcher
 
-		nodeConfig, err = nodeReq.Config.String()
-		if err != nil {
-			return provision.NodeInfo{}, err
+		if nodeReq.RawConfig != "" {
+			nodeConfig = nodeReq.RawConfig
+		} else {
+			nodeConfig, err = nodeReq.Config.String()
+			if err != nil {
+				return provision.NodeInfo{}, err
+			}
 		}
 	}
 
@@ -108,6 +117,7 @@ func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRe
 	launchConfig := LaunchConfig{
 		QemuExecutable:    arch.QemuExecutable(),
 		DiskPaths:         diskPaths,
+		DiskBlkdebugPaths: diskBlkdebugPaths,
 		VCPUCount:         vcpuCount,
 		MemSize:           memSize,
 		KernelArgs:        cmdline.String(),
@@ -45,6 +45,28 @@ func (p *Provisioner) CreateLoadBalancer(state *State, clusterReq provision.Clus
 		"--loadbalancer-upstreams", strings.Join(masterIPs, ","),
 	}
 
+	lbConfig := clusterReq.Network.LoadBalancer
+
+	if lbConfig.DialTimeout != 0 {
+		args = append(args, "--dial-timeout", lbConfig.DialTimeout.String())
+	}
+
+	if lbConfig.KeepAlivePeriod != 0 {
+		args = append(args, "--keep-alive-period", lbConfig.KeepAlivePeriod.String())
+	}
+
+	if lbConfig.TCPUserTimeout != 0 {
+		args = append(args, "--tcp-user-timeout", lbConfig.TCPUserTimeout.String())
+	}
+
+	if lbConfig.UpstreamsDNSName != "" {
+		args = append(args, "--loadbalancer-upstreams-dns-name", lbConfig.UpstreamsDNSName)
+	}
+
+	if lbConfig.UpstreamsDNSRefresh != 0 {
+		args = append(args, "--loadbalancer-upstreams-dns-refresh", lbConfig.UpstreamsDNSRefresh.String())
+	}
+
 	cmd := exec.Command(clusterReq.SelfExecutable, args...)
 	cmd.Stdout = logFile
 	cmd.Stderr = logFile
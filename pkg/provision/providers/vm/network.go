@@ -134,6 +134,10 @@ func (p *Provisioner) CreateNetwork(ctx context.Context, state *State, network p
 
 // DestroyNetwork destroy bridge interface by name to clean up.
 func (p *Provisioner) DestroyNetwork(state *State) error {
+	if err := p.destroyPartitions(state); err != nil {
+		return fmt.Errorf("error removing network partitions: %w", err)
+	}
+
 	iface, err := net.InterfaceByName(state.BridgeName)
 	if err != nil {
 		return fmt.Errorf("error looking up bridge interface %q: %w", state.BridgeName, err)
@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/coreos/go-iptables/iptables"
+
+	"github.com/talos-systems/talos/pkg/provision"
+)
+
+const bridgeNFCallPath = "/proc/sys/net/bridge/bridge-nf-call-"
+
+// PartitionNodes drops all traffic between nodes in groups a and b.
+//
+// Traffic between the VMs is filtered on the bridge, so partitions can be combined
+// by calling PartitionNodes several times.
+func (p *Provisioner) PartitionNodes(ctx context.Context, cluster provision.Cluster, a, b []net.IP) error {
+	state, ok := cluster.(*State)
+	if !ok {
+		return fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	chain := partitionChainName(state.BridgeName)
+
+	for _, proto := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
+		isIPv6 := proto == iptables.ProtocolIPv6
+
+		var rules [][]string
+
+		for _, src := range a {
+			for _, dst := range b {
+				if (src.To4() == nil) != isIPv6 || (dst.To4() == nil) != isIPv6 {
+					continue
+				}
+
+				rules = append(rules,
+					[]string{"-s", src.String(), "-d", dst.String(), "-j", "DROP"},
+					[]string{"-s", dst.String(), "-d", src.String(), "-j", "DROP"},
+				)
+			}
+		}
+
+		if len(rules) == 0 {
+			continue
+		}
+
+		// bridged traffic is only visible to iptables with br_netfilter enabled
+		if err := enableBridgeNFCall(proto); err != nil {
+			return err
+		}
+
+		ipt, err := iptables.NewWithProtocol(proto)
+		if err != nil {
+			return fmt.Errorf("error accessing iptables: %w", err)
+		}
+
+		exists, err := ipt.ChainExists("filter", chain)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			if err = ipt.NewChain("filter", chain); err != nil {
+				return fmt.Errorf("error creating chain %q: %w", chain, err)
+			}
+		}
+
+		jumpRule := []string{"-i", state.BridgeName, "-o", state.BridgeName, "-j", chain}
+
+		exists, err = ipt.Exists("filter", "FORWARD", jumpRule...)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			if err = ipt.Insert("filter", "FORWARD", 1, jumpRule...); err != nil {
+				return fmt.Errorf("error inserting jump rule to %q: %w", chain, err)
+			}
+		}
+
+		for _, rule := range rules {
+			if err = ipt.AppendUnique("filter", chain, rule...); err != nil {
+				return fmt.Errorf("error appending rule to %q: %w", chain, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// HealPartitions restores traffic between all the nodes of the cluster.
+func (p *Provisioner) HealPartitions(ctx context.Context, cluster provision.Cluster) error {
+	state, ok := cluster.(*State)
+	if !ok {
+		return fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	return p.destroyPartitions(state)
+}
+
+func (p *Provisioner) destroyPartitions(state *State) error {
+	chain := partitionChainName(state.BridgeName)
+
+	for _, proto := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
+		ipt, err := iptables.NewWithProtocol(proto)
+		if err != nil {
+			return fmt.Errorf("error accessing iptables: %w", err)
+		}
+
+		exists, err := ipt.ChainExists("filter", chain)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			continue
+		}
+
+		if err = ipt.DeleteIfExists("filter", "FORWARD", "-i", state.BridgeName, "-o", state.BridgeName, "-j", chain); err != nil {
+			return fmt.Errorf("error deleting jump rule to %q: %w", chain, err)
+		}
+
+		if err = ipt.ClearAndDeleteChain("filter", chain); err != nil {
+			return fmt.Errorf("error deleting chain %q: %w", chain, err)
+		}
+	}
+
+	return nil
+}
+
+func partitionChainName(bridgeName string) string {
+	return strings.ToUpper(bridgeName) + "-PARTITION"
+}
+
+func enableBridgeNFCall(proto iptables.Protocol) error {
+	path := bridgeNFCallPath + "iptables"
+	if proto == iptables.ProtocolIPv6 {
+		path = bridgeNFCallPath + "ip6tables"
+	}
+
+	if err := ioutil.WriteFile(path, []byte("1"), 0o644); err != nil {
+		return fmt.Errorf("error enabling bridge netfilter (is br_netfilter module loaded?): %w", err)
+	}
+
+	return nil
+}
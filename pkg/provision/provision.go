@@ -8,6 +8,7 @@ package provision
 import (
 	"context"
 	"io"
+	"net"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 )
@@ -29,3 +30,11 @@ type Provisioner interface {
 
 	UserDiskName(index int) string
 }
+
+// NetworkPartitioner is implemented by provisioners which can simulate network partitions between the nodes.
+type NetworkPartitioner interface {
+	// PartitionNodes drops all traffic between nodes in groups a and b.
+	PartitionNodes(ctx context.Context, cluster Cluster, a, b []net.IP) error
+	// HealPartitions restores traffic between all the nodes of the cluster.
+	HealPartitions(ctx context.Context, cluster Cluster) error
+}
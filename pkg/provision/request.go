@@ -7,6 +7,7 @@ package provision
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
@@ -42,6 +43,19 @@ type CNIConfig struct {
 	BundleURL string
 }
 
+// LoadBalancerConfig describes load balancer part of NetworkRequest.
+//
+// Zero values keep the load balancer defaults.
+type LoadBalancerConfig struct {
+	DialTimeout     time.Duration
+	KeepAlivePeriod time.Duration
+	TCPUserTimeout  time.Duration
+
+	// DNS name resolved to additional upstreams, and the interval to refresh it.
+	UpstreamsDNSName    string
+	UpstreamsDNSRefresh time.Duration
+}
+
 // NetworkRequest describes cluster network.
 type NetworkRequest struct {
 	Name         string
@@ -52,6 +66,9 @@ type NetworkRequest struct {
 
 	// CNI-specific parameters.
 	CNI CNIConfig
+
+	// Load balancer parameters (VM only).
+	LoadBalancer LoadBalancerConfig
 }
 
 // NodeRequests is a list of NodeRequest.
@@ -124,6 +141,8 @@ type Disk struct {
 	Size uint64
 	// Partitions represents the list of partitions.
 	Partitions []*v1alpha1.DiskPartition
+	// WriteErrors makes writes to the disk fail with I/O error (QEMU provisioner).
+	WriteErrors bool
 }
 
 // NodeRequest describes a request for a node.
@@ -152,6 +171,10 @@ type NodeRequest struct {
 
 	// BadRTC resets RTC to well known time in the past (QEMU provisioner).
 	BadRTC bool
+	// RawConfig is served to the node as is instead of Config (QEMU provisioner).
+	//
+	// RawConfig is not validated, so it can be used to test handling of invalid machine configuration.
+	RawConfig string
 
 	// PXE-booted VMs
 	PXEBooted        bool
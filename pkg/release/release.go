@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package release provides the release metadata fetched from the release index.
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+// Latest is the version which resolves to the most recent stable release in the index.
+const Latest = "latest"
+
+// Index is the list of the releases published in the release index.
+//
+// Release index is a JSON document:
+//
+//	{
+//	  "releases": [
+//	    {
+//	      "version": "v0.11.0",
+//	      "installerImage": "ghcr.io/talos-systems/installer:v0.11.0",
+//	      "artifacts": [
+//	        {
+//	          "name": "talosctl-cni-bundle-amd64.tar.gz",
+//	          "url": "https://github.com/talos-systems/talos/releases/download/v0.11.0/talosctl-cni-bundle-amd64.tar.gz",
+//	          "sha256": "..."
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+type Index struct {
+	Releases []Release `json:"releases"`
+}
+
+// Release describes the artifacts of a single release.
+type Release struct {
+	Version        string     `json:"version"`
+	InstallerImage string     `json:"installerImage,omitempty"`
+	Artifacts      []Artifact `json:"artifacts"`
+}
+
+// Artifact is a downloadable release artifact.
+type Artifact struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// Fetch downloads the release index.
+func Fetch(ctx context.Context, indexURL string) (*Index, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching release index %q: %w", indexURL, err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching release index %q: received %d", indexURL, resp.StatusCode)
+	}
+
+	var index Index
+
+	if err = json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("error decoding release index %q: %w", indexURL, err)
+	}
+
+	return &index, nil
+}
+
+// Resolve returns the release with the specified version.
+//
+// Version Latest resolves to the release with the highest version, pre-releases are ignored.
+func (index *Index) Resolve(version string) (*Release, error) {
+	if version != Latest {
+		for i := range index.Releases {
+			if strings.TrimPrefix(index.Releases[i].Version, "v") == strings.TrimPrefix(version, "v") {
+				return &index.Releases[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("release %q is not found in the release index", version)
+	}
+
+	var (
+		latest        *Release
+		latestVersion *semver.Version
+	)
+
+	for i := range index.Releases {
+		v, err := semver.NewVersion(strings.TrimPrefix(index.Releases[i].Version, "v"))
+		if err != nil {
+			// skip malformed versions
+			continue
+		}
+
+		if v.PreRelease != "" {
+			continue
+		}
+
+		if latestVersion == nil || latestVersion.LessThan(*v) {
+			latest, latestVersion = &index.Releases[i], v
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no stable releases found in the release index")
+	}
+
+	return latest, nil
+}
+
+// Artifact returns the release artifact with the specified name.
+func (release *Release) Artifact(name string) (*Artifact, error) {
+	for i := range release.Artifacts {
+		if release.Artifacts[i].Name == name {
+			return &release.Artifacts[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("artifact %q is not found in release %q", name, release.Version)
+}
+
+// GetterURL returns the artifact URL in go-getter format.
+//
+// Checksum is appended to the URL, so that go-getter verifies the downloaded artifact.
+func (artifact *Artifact) GetterURL() string {
+	if artifact.SHA256 == "" {
+		return artifact.URL
+	}
+
+	separator := "?"
+	if strings.Contains(artifact.URL, "?") {
+		separator = "&"
+	}
+
+	return artifact.URL + separator + "checksum=sha256:" + artifact.SHA256
+}
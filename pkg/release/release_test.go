@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package release_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/release"
+)
+
+const testIndex = `{
+  "releases": [
+    {"version": "v0.10.4", "artifacts": [{"name": "talosctl-cni-bundle-amd64.tar.gz", "url": "https://example.com/v0.10.4/talosctl-cni-bundle-amd64.tar.gz"}]},
+    {"version": "v0.12.0-alpha.1", "artifacts": []},
+    {"version": "v0.11.2", "installerImage": "ghcr.io/talos-systems/installer:v0.11.2", "artifacts": [{"name": "talosctl-cni-bundle-amd64.tar.gz", "url": "https://example.com/v0.11.2/talosctl-cni-bundle-amd64.tar.gz", "sha256": "abcd"}]},
+    {"version": "v0.11.0", "artifacts": []}
+  ]
+}`
+
+func TestFetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index.json" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Write([]byte(testIndex)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	index, err := release.Fetch(context.Background(), srv.URL+"/index.json")
+	require.NoError(t, err)
+	assert.Len(t, index.Releases, 4)
+
+	_, err = release.Fetch(context.Background(), srv.URL+"/missing.json")
+	assert.Error(t, err)
+
+	latest, err := index.Resolve(release.Latest)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.11.2", latest.Version)
+	assert.Equal(t, "ghcr.io/talos-systems/installer:v0.11.2", latest.InstallerImage)
+
+	artifact, err := latest.Artifact("talosctl-cni-bundle-amd64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/v0.11.2/talosctl-cni-bundle-amd64.tar.gz?checksum=sha256:abcd", artifact.GetterURL())
+
+	_, err = latest.Artifact("talosctl-cni-bundle-arm64.tar.gz")
+	assert.Error(t, err)
+
+	pinned, err := index.Resolve("0.10.4")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.10.4", pinned.Version)
+	assert.Empty(t, pinned.InstallerImage)
+
+	artifact, err = pinned.Artifact("talosctl-cni-bundle-amd64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/v0.10.4/talosctl-cni-bundle-amd64.tar.gz", artifact.GetterURL())
+
+	_, err = index.Resolve("v0.9.0")
+	assert.Error(t, err)
+}
@@ -54,6 +54,7 @@ type K8sControlPlaneAPIServerSpec struct {
 	ServiceCIDR          string            `yaml:"serviceCIDR"`
 	ExtraArgs            map[string]string `yaml:"extraArgs"`
 	ExtraVolumes         []K8sExtraVolume  `yaml:"extraVolumes"`
+	DisableAggregator    bool              `yaml:"disableAggregator"`
 }
 
 // K8sControlPlaneControllerManagerSpec is configuration for kube-controller-manager.
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+)
+
+// MemberType is type of Member resource.
+const MemberType = resource.Type("EtcdMembers.etcd.talos.dev")
+
+// Member resource holds the status of the etcd cluster member.
+//
+// Member ID is the etcd member ID in hex.
+type Member struct {
+	md   resource.Metadata
+	spec MemberSpec
+}
+
+// MemberSpec describes the status of the etcd cluster member.
+type MemberSpec struct {
+	Hostname   string   `yaml:"hostname"`
+	PeerURLs   []string `yaml:"peerURLs"`
+	ClientURLs []string `yaml:"clientURLs"`
+	// Leader is set if the member is the etcd cluster leader.
+	Leader bool `yaml:"leader"`
+	// Healthy is set if the member responds to the status requests.
+	Healthy bool `yaml:"healthy"`
+	// DBSize is the size of the member database, in bytes.
+	DBSize int64 `yaml:"dbSize"`
+}
+
+// NewMember initializes a Member resource.
+func NewMember(namespace resource.Namespace, id resource.ID) *Member {
+	r := &Member{
+		md:   resource.NewMetadata(namespace, MemberType, id, resource.VersionUndefined),
+		spec: MemberSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *Member) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *Member) Spec() interface{} {
+	return r.spec
+}
+
+func (r *Member) String() string {
+	return fmt.Sprintf("etcd.Member(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *Member) DeepCopy() resource.Resource {
+	return &Member{
+		md: r.md,
+		spec: MemberSpec{
+			Hostname:   r.spec.Hostname,
+			PeerURLs:   append([]string(nil), r.spec.PeerURLs...),
+			ClientURLs: append([]string(nil), r.spec.ClientURLs...),
+			Leader:     r.spec.Leader,
+			Healthy:    r.spec.Healthy,
+			DBSize:     r.spec.DBSize,
+		},
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *Member) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MemberType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Hostname",
+				JSONPath: "{.hostname}",
+			},
+			{
+				Name:     "Leader",
+				JSONPath: "{.leader}",
+			},
+			{
+				Name:     "Healthy",
+				JSONPath: "{.healthy}",
+			},
+			{
+				Name:     "DB Size",
+				JSONPath: "{.dbSize}",
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *Member) TypedSpec() *MemberSpec {
+	return &r.spec
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+)
+
+// QuorumStatusType is type of QuorumStatus resource.
+const QuorumStatusType = resource.Type("EtcdQuorumStatuses.etcd.talos.dev")
+
+// QuorumStatusID is a singleton resource ID for QuorumStatus.
+const QuorumStatusID = resource.ID("quorum")
+
+// QuorumStatus resource holds the etcd cluster quorum status.
+type QuorumStatus struct {
+	md   resource.Metadata
+	spec QuorumStatusSpec
+}
+
+// QuorumStatusSpec describes the etcd cluster quorum status.
+type QuorumStatusSpec struct {
+	// Healthy is set if the majority of the members is healthy and the cluster has a leader.
+	Healthy        bool   `yaml:"healthy"`
+	Members        int    `yaml:"members"`
+	HealthyMembers int    `yaml:"healthyMembers"`
+	Leader         string `yaml:"leader"`
+}
+
+// NewQuorumStatus initializes a QuorumStatus resource.
+func NewQuorumStatus(namespace resource.Namespace, id resource.ID) *QuorumStatus {
+	r := &QuorumStatus{
+		md:   resource.NewMetadata(namespace, QuorumStatusType, id, resource.VersionUndefined),
+		spec: QuorumStatusSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *QuorumStatus) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *QuorumStatus) Spec() interface{} {
+	return r.spec
+}
+
+func (r *QuorumStatus) String() string {
+	return fmt.Sprintf("etcd.QuorumStatus(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *QuorumStatus) DeepCopy() resource.Resource {
+	return &QuorumStatus{
+		md:   r.md,
+		spec: r.spec,
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *QuorumStatus) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             QuorumStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Healthy",
+				JSONPath: "{.healthy}",
+			},
+			{
+				Name:     "Members",
+				JSONPath: "{.members}",
+			},
+			{
+				Name:     "Healthy Members",
+				JSONPath: "{.healthyMembers}",
+			},
+			{
+				Name:     "Leader",
+				JSONPath: "{.leader}",
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *QuorumStatus) TypedSpec() *QuorumStatusSpec {
+	return &r.spec
+}
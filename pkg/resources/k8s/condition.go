@@ -59,3 +59,65 @@ func (condition *NodenameReadyCondition) Wait(ctx context.Context) error {
 
 	return err
 }
+
+// KubeletReservedReadyCondition implements condition which waits for the kubelet reserved resources to be computed.
+type KubeletReservedReadyCondition struct {
+	state state.State
+}
+
+// NewKubeletReservedReadyCondition builds a condition which waits for the kubelet reserved resources to be computed.
+func NewKubeletReservedReadyCondition(state state.State) *KubeletReservedReadyCondition {
+	return &KubeletReservedReadyCondition{
+		state: state,
+	}
+}
+
+func (condition *KubeletReservedReadyCondition) String() string {
+	return "kubelet reserved resources"
+}
+
+// Wait implements condition interface.
+func (condition *KubeletReservedReadyCondition) Wait(ctx context.Context) error {
+	_, err := condition.state.WatchFor(
+		ctx,
+		resource.NewMetadata(ControlPlaneNamespaceName, KubeletReservedType, KubeletID, resource.VersionUndefined),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			return !resource.IsTombstone(r), nil
+		}),
+	)
+
+	return err
+}
+
+// NodeIPReadyCondition implements condition which waits for the kubelet node IP to be picked.
+type NodeIPReadyCondition struct {
+	state state.State
+}
+
+// NewNodeIPReadyCondition builds a condition which waits for the kubelet node IP to be picked.
+func NewNodeIPReadyCondition(state state.State) *NodeIPReadyCondition {
+	return &NodeIPReadyCondition{
+		state: state,
+	}
+}
+
+func (condition *NodeIPReadyCondition) String() string {
+	return "node IP"
+}
+
+// Wait implements condition interface.
+func (condition *NodeIPReadyCondition) Wait(ctx context.Context) error {
+	_, err := condition.state.WatchFor(
+		ctx,
+		resource.NewMetadata(ControlPlaneNamespaceName, NodeIPType, KubeletID, resource.VersionUndefined),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return false, nil
+			}
+
+			return len(r.(*NodeIP).TypedSpec().Addresses) > 0, nil
+		}),
+	)
+
+	return err
+}
@@ -26,9 +26,11 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []resource.Resource{
 		&k8s.Endpoint{},
+		&k8s.KubeletReserved{},
 		&k8s.ManifestStatus{},
 		&k8s.Manifest{},
 		&k8s.Nodename{},
+		&k8s.NodeIP{},
 		&k8s.SecretsStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.StaticPod{},
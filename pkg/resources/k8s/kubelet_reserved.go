@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+)
+
+// KubeletReservedType is type of KubeletReserved resource.
+const KubeletReservedType = resource.Type("KubeletReserveds.kubernetes.talos.dev")
+
+// KubeletReserved resource holds resources reserved for the system and Kubernetes daemons.
+type KubeletReserved struct {
+	md   resource.Metadata
+	spec KubeletReservedSpec
+}
+
+// KubeletReservedSpec holds reserved resources in the kubelet `--system-reserved` and `--kube-reserved` format.
+type KubeletReservedSpec struct {
+	SystemReserved map[string]string `yaml:"systemReserved"`
+	KubeReserved   map[string]string `yaml:"kubeReserved"`
+}
+
+// NewKubeletReserved initializes a KubeletReserved resource.
+func NewKubeletReserved(namespace resource.Namespace, id resource.ID) *KubeletReserved {
+	r := &KubeletReserved{
+		md:   resource.NewMetadata(namespace, KubeletReservedType, id, resource.VersionUndefined),
+		spec: KubeletReservedSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *KubeletReserved) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *KubeletReserved) Spec() interface{} {
+	return r.spec
+}
+
+func (r *KubeletReserved) String() string {
+	return fmt.Sprintf("k8s.KubeletReserved(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *KubeletReserved) DeepCopy() resource.Resource {
+	return &KubeletReserved{
+		md: r.md,
+		spec: KubeletReservedSpec{
+			SystemReserved: copyStringMap(r.spec.SystemReserved),
+			KubeReserved:   copyStringMap(r.spec.KubeReserved),
+		},
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *KubeletReserved) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubeletReservedType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "System",
+				JSONPath: "{.systemReserved}",
+			},
+			{
+				Name:     "Kube",
+				JSONPath: "{.kubeReserved}",
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *KubeletReserved) TypedSpec() *KubeletReservedSpec {
+	return &r.spec
+}
+
+func copyStringMap(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string]string, len(in))
+
+	for k, v := range in {
+		out[k] = v
+	}
+
+	return out
+}
@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+// Well-known IDs of the network.NodeAddressFilter resources managed for Kubernetes.
+const (
+	// NodeAddressFilterNoK8s filters out Kubernetes pod and service addresses.
+	NodeAddressFilterNoK8s = "no-k8s"
+	// NodeAddressFilterOnlyK8s keeps only Kubernetes pod and service addresses.
+	NodeAddressFilterOnlyK8s = "only-k8s"
+)
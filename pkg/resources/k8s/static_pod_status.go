@@ -7,10 +7,13 @@ package k8s
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	v1 "k8s.io/api/core/v1"
+
+	"github.com/talos-systems/talos/pkg/resources/config"
 )
 
 // StaticPodStatusType is type of StaticPodStatus resource.
@@ -100,3 +103,32 @@ func (r *StaticPodStatus) Status() *v1.PodStatus {
 func (r *StaticPodStatus) SetStatus(status *v1.PodStatus) {
 	r.spec.PodStatus = status
 }
+
+// ControlPlaneStaticPods is the list of the control plane static pod app names.
+var ControlPlaneStaticPods = []string{
+	config.K8sControlPlaneAPIServerID,
+	config.K8sControlPlaneControllerManagerID,
+	config.K8sControlPlaneSchedulerID,
+}
+
+// IsMirrorPodOf checks whether the mirror pod name belongs to the static pod of the app.
+//
+// Mirror pod name is the static pod name with the node name appended.
+func IsMirrorPodOf(name, app string) bool {
+	return strings.HasPrefix(name, app+"-")
+}
+
+// PodReady checks whether the pod is running and reports the Ready condition.
+func PodReady(status *v1.PodStatus) bool {
+	if status == nil || status.Phase != v1.PodRunning {
+		return false
+	}
+
+	for _, cond := range status.Conditions {
+		if cond.Type == v1.PodReady {
+			return cond.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
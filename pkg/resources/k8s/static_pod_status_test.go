@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/talos-systems/talos/pkg/resources/k8s"
+)
+
+func TestIsMirrorPodOf(t *testing.T) {
+	assert.True(t, k8s.IsMirrorPodOf("kube-apiserver-master-1", "kube-apiserver"))
+	assert.False(t, k8s.IsMirrorPodOf("kube-apiserver", "kube-apiserver"))
+	assert.False(t, k8s.IsMirrorPodOf("kube-scheduler-master-1", "kube-apiserver"))
+}
+
+func TestPodReady(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		status *v1.PodStatus
+		ready  bool
+	}{
+		{
+			name: "no status",
+		},
+		{
+			name: "ready",
+			status: &v1.PodStatus{
+				Phase: v1.PodRunning,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodScheduled, Status: v1.ConditionTrue},
+					{Type: v1.PodReady, Status: v1.ConditionTrue},
+				},
+			},
+			ready: true,
+		},
+		{
+			name: "not ready",
+			status: &v1.PodStatus{
+				Phase: v1.PodRunning,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodReady, Status: v1.ConditionFalse},
+				},
+			},
+		},
+		{
+			name: "no ready condition",
+			status: &v1.PodStatus{
+				Phase: v1.PodRunning,
+			},
+		},
+		{
+			name: "pending",
+			status: &v1.PodStatus{
+				Phase: v1.PodPending,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodReady, Status: v1.ConditionTrue},
+				},
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.ready, k8s.PodReady(tt.status))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"inet.af/netaddr"
 )
 
 // HostnameSpecType is type of HostnameSpec resource.
@@ -46,6 +47,11 @@ func (spec *HostnameSpecSpec) Validate() error {
 	return nil
 }
 
+// DefaultHostname returns the default hostname derived from the node address.
+func DefaultHostname(addr netaddr.IP) string {
+	return fmt.Sprintf("talos-%s", strings.ReplaceAll(strings.ReplaceAll(addr.String(), ":", ""), ".", "-"))
+}
+
 // FQDN returns the fully-qualified domain name.
 func (spec *HostnameSpecSpec) FQDN() string {
 	if spec.Domainname == "" {
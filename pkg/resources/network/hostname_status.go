@@ -37,6 +37,10 @@ func (spec *HostnameStatusSpec) FQDN() string {
 
 // DNSNames returns DNS names to be added to the certificate based on the hostname and fqdn.
 func (spec *HostnameStatusSpec) DNSNames() []string {
+	if spec.Hostname == "" {
+		return nil
+	}
+
 	result := []string{spec.Hostname}
 
 	if spec.Domainname != "" {
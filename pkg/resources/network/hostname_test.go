@@ -0,0 +1,25 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+func TestDefaultHostname(t *testing.T) {
+	assert.Equal(t, "talos-172-20-0-2", network.DefaultHostname(netaddr.MustParseIP("172.20.0.2")))
+	assert.Equal(t, "talos-2001db81", network.DefaultHostname(netaddr.MustParseIP("2001:db8::1")))
+}
+
+func TestHostnameStatusDNSNames(t *testing.T) {
+	assert.Empty(t, (&network.HostnameStatusSpec{}).DNSNames())
+	assert.Equal(t, []string{"foo"}, (&network.HostnameStatusSpec{Hostname: "foo"}).DNSNames())
+	assert.Equal(t, []string{"foo", "foo.example.com"}, (&network.HostnameStatusSpec{Hostname: "foo", Domainname: "example.com"}).DNSNames())
+}
@@ -33,6 +33,7 @@ func TestRegisterResource(t *testing.T) {
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NodeAddress{},
+		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
@@ -37,6 +37,13 @@ const (
 	NodeAddressAccumulativeID = "accumulative"
 )
 
+// FilteredNodeAddressID returns resource ID for node addresses with filter applied.
+//
+// Kind is either NodeAddressCurrentID or NodeAddressAccumulativeID.
+func FilteredNodeAddressID(kind resource.ID, filterID string) resource.ID {
+	return kind + "-" + filterID
+}
+
 // NodeAddressSpec describes a set of node addresses.
 type NodeAddressSpec struct {
 	Addresses []netaddr.IP `yaml:"addresses"`
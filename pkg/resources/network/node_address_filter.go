@@ -0,0 +1,144 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"inet.af/netaddr"
+)
+
+// NodeAddressFilterType is type of NodeAddressFilter resource.
+const NodeAddressFilterType = resource.Type("NodeAddressFilters.net.talos.dev")
+
+// NodeAddressFilter resource holds filter for NodeAddress resources.
+//
+// For each filter, NodeAddress resources are produced with IDs built with FilteredNodeAddressID.
+type NodeAddressFilter struct {
+	md   resource.Metadata
+	spec NodeAddressFilterSpec
+}
+
+// NodeAddressFilterSpec describes a filter for NodeAddresses.
+//
+// Address is included in the filtered set if it matches any of the IncludeSubnets (or IncludeSubnets is empty),
+// and it doesn't match any of the ExcludeSubnets; and if it is assigned to any of the IncludeLinks
+// (or IncludeLinks is empty), and it is not assigned to any of the ExcludeLinks.
+type NodeAddressFilterSpec struct {
+	// Address is skipped if it doesn't match any of the includeSubnets (if includeSubnets is not empty).
+	IncludeSubnets []netaddr.IPPrefix `yaml:"includeSubnets"`
+	// Address is skipped if it matches any of the excludeSubnets.
+	ExcludeSubnets []netaddr.IPPrefix `yaml:"excludeSubnets"`
+	// Address is skipped if it's not assigned to any of the includeLinks (if includeLinks is not empty).
+	IncludeLinks []string `yaml:"includeLinks,omitempty"`
+	// Address is skipped if it's assigned to any of the excludeLinks.
+	ExcludeLinks []string `yaml:"excludeLinks,omitempty"`
+}
+
+// Match checks whether the address assigned to the link passes the filter.
+func (spec *NodeAddressFilterSpec) Match(ip netaddr.IP, linkName string) bool {
+	if len(spec.IncludeSubnets) > 0 {
+		included := false
+
+		for _, subnet := range spec.IncludeSubnets {
+			if subnet.Contains(ip) {
+				included = true
+
+				break
+			}
+		}
+
+		if !included {
+			return false
+		}
+	}
+
+	for _, subnet := range spec.ExcludeSubnets {
+		if subnet.Contains(ip) {
+			return false
+		}
+	}
+
+	if len(spec.IncludeLinks) > 0 && !containsLink(spec.IncludeLinks, linkName) {
+		return false
+	}
+
+	return !containsLink(spec.ExcludeLinks, linkName)
+}
+
+func containsLink(links []string, linkName string) bool {
+	for _, link := range links {
+		if link == linkName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewNodeAddressFilter initializes a NodeAddressFilter resource.
+func NewNodeAddressFilter(namespace resource.Namespace, id resource.ID) *NodeAddressFilter {
+	r := &NodeAddressFilter{
+		md:   resource.NewMetadata(namespace, NodeAddressFilterType, id, resource.VersionUndefined),
+		spec: NodeAddressFilterSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *NodeAddressFilter) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *NodeAddressFilter) Spec() interface{} {
+	return r.spec
+}
+
+func (r *NodeAddressFilter) String() string {
+	return fmt.Sprintf("network.NodeAddressFilter(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *NodeAddressFilter) DeepCopy() resource.Resource {
+	return &NodeAddressFilter{
+		md: r.md,
+		spec: NodeAddressFilterSpec{
+			IncludeSubnets: append([]netaddr.IPPrefix(nil), r.spec.IncludeSubnets...),
+			ExcludeSubnets: append([]netaddr.IPPrefix(nil), r.spec.ExcludeSubnets...),
+			IncludeLinks:   append([]string(nil), r.spec.IncludeLinks...),
+			ExcludeLinks:   append([]string(nil), r.spec.ExcludeLinks...),
+		},
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *NodeAddressFilter) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeAddressFilterType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Include",
+				JSONPath: `{.includeSubnets}`,
+			},
+			{
+				Name:     "Exclude",
+				JSONPath: `{.excludeSubnets}`,
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *NodeAddressFilter) TypedSpec() *NodeAddressFilterSpec {
+	return &r.spec
+}
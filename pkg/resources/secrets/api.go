@@ -30,15 +30,38 @@ type API struct {
 
 // APICertsSpec describes etcd certs secrets.
 type APICertsSpec struct {
-	CA     *x509.PEMEncodedCertificateAndKey `yaml:"ca"` // only cert is passed, without key
-	Client *x509.PEMEncodedCertificateAndKey `yaml:"client"`
-	Server *x509.PEMEncodedCertificateAndKey `yaml:"server"`
+	CA          *x509.PEMEncodedCertificateAndKey   `yaml:"ca"`          // only cert is passed, without key
+	AcceptedCAs []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs"` // only certs are passed, without keys
+	Client      *x509.PEMEncodedCertificateAndKey   `yaml:"client"`
+	Server      *x509.PEMEncodedCertificateAndKey   `yaml:"server"`
+}
+
+// CABundle returns PEM-encoded CA certificates trusted by the API: the machine CA followed by the accepted CAs.
+func (spec *APICertsSpec) CABundle() []byte {
+	bundle := append([]byte(nil), spec.CA.Crt...)
+
+	for _, ca := range spec.AcceptedCAs {
+		if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+			bundle = append(bundle, '\n')
+		}
+
+		bundle = append(bundle, ca.Crt...)
+	}
+
+	return bundle
 }
 
 // MarshalProto implements ProtoMarshaler.
 func (spec *APICertsSpec) MarshalProto() ([]byte, error) {
+	acceptedCAs := make([][]byte, 0, len(spec.AcceptedCAs))
+
+	for _, ca := range spec.AcceptedCAs {
+		acceptedCAs = append(acceptedCAs, ca.Crt)
+	}
+
 	protoSpec := secretspb.APISpec{
-		CaPem: spec.CA.Crt,
+		CaPem:          spec.CA.Crt,
+		AcceptedCaPems: acceptedCAs,
 		Client: &secretspb.CertAndKeyPEM{
 			Cert: spec.Client.Crt,
 			Key:  spec.Client.Key,
@@ -127,6 +150,12 @@ func (r *API) UnmarshalProto(md *resource.Metadata, protoBytes []byte) error {
 		},
 	}
 
+	for _, ca := range protoSpec.AcceptedCaPems {
+		r.spec.AcceptedCAs = append(r.spec.AcceptedCAs, &x509.PEMEncodedCertificateAndKey{
+			Crt: ca,
+		})
+	}
+
 	return nil
 }
 
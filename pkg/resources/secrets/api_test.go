@@ -20,6 +20,11 @@ func TestAPIProtobufMarshal(t *testing.T) {
 	r.TypedSpec().CA = &x509.PEMEncodedCertificateAndKey{
 		Crt: []byte("foo"),
 	}
+	r.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificateAndKey{
+		{
+			Crt: []byte("far"),
+		},
+	}
 	r.TypedSpec().Client = &x509.PEMEncodedCertificateAndKey{
 		Crt: []byte("bar"),
 		Key: []byte("baz"),
@@ -43,3 +48,24 @@ func TestAPIProtobufMarshal(t *testing.T) {
 
 	require.True(t, resource.Equal(r, r2))
 }
+
+func TestAPICABundle(t *testing.T) {
+	spec := secrets.APICertsSpec{
+		CA: &x509.PEMEncodedCertificateAndKey{
+			Crt: []byte("foo"),
+		},
+	}
+
+	require.Equal(t, "foo", string(spec.CABundle()))
+
+	spec.AcceptedCAs = []*x509.PEMEncodedCertificateAndKey{
+		{
+			Crt: []byte("bar\n"),
+		},
+		{
+			Crt: []byte("baz\n"),
+		},
+	}
+
+	require.Equal(t, "foo\nbar\nbaz\n", string(spec.CABundle()))
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+)
+
+// KubernetesStatusType is type of KubernetesStatus resource.
+const KubernetesStatusType = resource.Type("KubernetesStatuses.secrets.talos.dev")
+
+// KubernetesStatus describes the state of Kubernetes secrets generation.
+//
+// KubernetesStatus uses the same ID as Kubernetes resource (KubernetesID).
+type KubernetesStatus struct {
+	md   resource.Metadata
+	spec KubernetesStatusSpec
+}
+
+// KubernetesStatusSpec describes the state of Kubernetes secrets generation.
+type KubernetesStatusSpec struct {
+	Ready bool   `yaml:"ready"`
+	Error string `yaml:"error"`
+}
+
+// NewKubernetesStatus initializes a KubernetesStatus resource.
+func NewKubernetesStatus() *KubernetesStatus {
+	r := &KubernetesStatus{
+		md:   resource.NewMetadata(NamespaceName, KubernetesStatusType, KubernetesID, resource.VersionUndefined),
+		spec: KubernetesStatusSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *KubernetesStatus) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *KubernetesStatus) Spec() interface{} {
+	return r.spec
+}
+
+func (r *KubernetesStatus) String() string {
+	return fmt.Sprintf("secrets.KubernetesStatus(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *KubernetesStatus) DeepCopy() resource.Resource {
+	return &KubernetesStatus{
+		md:   r.md,
+		spec: r.spec,
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *KubernetesStatus) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Ready",
+				JSONPath: "{.ready}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *KubernetesStatus) TypedSpec() *KubernetesStatusSpec {
+	return &r.spec
+}
@@ -33,9 +33,10 @@ type Root struct {
 
 // RootOSSpec describes operating system CA.
 type RootOSSpec struct {
-	CA              *x509.PEMEncodedCertificateAndKey `yaml:"ca"`
-	CertSANIPs      []netaddr.IP                      `yaml:"certSANIPs"`
-	CertSANDNSNames []string                          `yaml:"certSANDNSNames"`
+	CA              *x509.PEMEncodedCertificateAndKey   `yaml:"ca"`
+	AcceptedCAs     []*x509.PEMEncodedCertificateAndKey `yaml:"acceptedCAs"` // only certs are passed, without keys
+	CertSANIPs      []netaddr.IP                        `yaml:"certSANIPs"`
+	CertSANDNSNames []string                            `yaml:"certSANDNSNames"`
 
 	Token string `yaml:"token"`
 }
@@ -53,9 +54,10 @@ type RootKubernetesSpec struct {
 	APIServerIPs []net.IP `yaml:"apiServerIPs"`
 	DNSDomain    string   `yaml:"dnsDomain"`
 
-	CA             *x509.PEMEncodedCertificateAndKey `yaml:"ca"`
-	ServiceAccount *x509.PEMEncodedKey               `yaml:"serviceAccount"`
-	AggregatorCA   *x509.PEMEncodedCertificateAndKey `yaml:"aggregatorCA"`
+	CA                           *x509.PEMEncodedCertificateAndKey `yaml:"ca"`
+	ServiceAccount               *x509.PEMEncodedKey               `yaml:"serviceAccount"`
+	ServiceAccountAdditionalKeys []*x509.PEMEncodedKey             `yaml:"serviceAccountAdditionalKeys"`
+	AggregatorCA                 *x509.PEMEncodedCertificateAndKey `yaml:"aggregatorCA"`
 
 	AESCBCEncryptionSecret string `yaml:"aesCBCEncryptionSecret"`
 
@@ -28,6 +28,7 @@ func TestRegisterResource(t *testing.T) {
 		&secrets.API{},
 		&secrets.Etcd{},
 		&secrets.Kubernetes{},
+		&secrets.KubernetesStatus{},
 		&secrets.Root{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
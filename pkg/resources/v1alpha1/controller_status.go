@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+)
+
+// ControllerStatusType is type of ControllerStatus resource.
+const ControllerStatusType = resource.Type("ControllerStatuses.v1alpha1.talos.dev")
+
+// Controller states.
+const (
+	ControllerStateRunning = "running"
+	ControllerStateFailed  = "failed"
+	ControllerStateStopped = "stopped"
+)
+
+// ControllerStatus describes the state of the controller in the controller runtime.
+//
+// Resource ID is the controller name.
+type ControllerStatus struct {
+	md   resource.Metadata
+	spec ControllerStatusSpec
+}
+
+// ControllerStatusSpec describes controller state.
+type ControllerStatusSpec struct {
+	State         string    `yaml:"state"`
+	LastReconcile time.Time `yaml:"lastReconcile"`
+	LastError     string    `yaml:"lastError"`
+}
+
+// NewControllerStatus initializes a ControllerStatus resource.
+func NewControllerStatus(id resource.ID) *ControllerStatus {
+	r := &ControllerStatus{
+		md:   resource.NewMetadata(NamespaceName, ControllerStatusType, id, resource.VersionUndefined),
+		spec: ControllerStatusSpec{},
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *ControllerStatus) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *ControllerStatus) Spec() interface{} {
+	return r.spec
+}
+
+func (r *ControllerStatus) String() string {
+	return fmt.Sprintf("v1alpha1.ControllerStatus(%q)", r.md.ID())
+}
+
+// DeepCopy implements resource.Resource.
+func (r *ControllerStatus) DeepCopy() resource.Resource {
+	return &ControllerStatus{
+		md:   r.md,
+		spec: r.spec,
+	}
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (r *ControllerStatus) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ControllerStatusType,
+		Aliases:          []resource.Type{"controller", "controllers"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "State",
+				JSONPath: "{.state}",
+			},
+			{
+				Name:     "Last Reconcile",
+				JSONPath: "{.lastReconcile}",
+			},
+			{
+				Name:     "Last Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+// TypedSpec allows to access the Spec with the proper type.
+func (r *ControllerStatus) TypedSpec() *ControllerStatusSpec {
+	return &r.spec
+}
@@ -26,6 +26,7 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []resource.Resource{
 		&v1alpha1.BootstrapStatus{},
+		&v1alpha1.ControllerStatus{},
 		&v1alpha1.Service{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package version
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+// CheckUpgrade verifies that upgrading from the current version to the target one is supported.
+//
+// Upgrades are supported to the next minor version at most, downgrades are supported to the previous minor version at most,
+// so that an upgrade can always be rolled back.
+// Versions which are not semantic versions (e.g. development builds) are not checked.
+func CheckUpgrade(current, target string) error {
+	from, err := semver.NewVersion(strings.TrimPrefix(current, "v"))
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	to, err := semver.NewVersion(strings.TrimPrefix(target, "v"))
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	switch {
+	case to.Major == from.Major && to.Minor == from.Minor:
+		return nil
+	case to.Major == from.Major && to.Minor+1 == from.Minor:
+		return nil
+	case to.Major < from.Major || to.Major == from.Major && to.Minor < from.Minor:
+		return fmt.Errorf("downgrade from %s to %s is not supported", current, target)
+	case to.Major == from.Major && to.Minor == from.Minor+1:
+		return nil
+	case to.Major == from.Major+1 && to.Minor == 0:
+		return nil
+	default:
+		return fmt.Errorf("upgrade from %s to %s is not supported, upgrade to v%d.%d first", current, target, from.Major, from.Minor+1)
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"bytes"
 	"fmt"
 	"runtime"
+	"strings"
 	"text/template"
 
+	"github.com/coreos/go-semver/semver"
+
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
 
@@ -44,6 +47,25 @@ func NewVersion() *machineapi.VersionInfo {
 		GoVersion: runtime.Version(),
 		Os:        runtime.GOOS,
 		Arch:      runtime.GOARCH,
+		Semver:    ParseSemver(Tag),
+	}
+}
+
+// ParseSemver returns the components of the semantic version in the tag.
+//
+// Nil is returned if the tag is not a semantic version (e.g. development builds).
+func ParseSemver(tag string) *machineapi.SemanticVersion {
+	v, err := semver.NewVersion(strings.TrimPrefix(tag, "v"))
+	if err != nil {
+		return nil
+	}
+
+	return &machineapi.SemanticVersion{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		PreRelease: string(v.PreRelease),
+		Metadata:   v.Metadata,
 	}
 }
 
@@ -4,11 +4,57 @@
 
 package version_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/version"
+)
+
+func TestCheckUpgrade(t *testing.T) {
+	for _, tt := range []struct {
+		current, target string
+		expectedError   string
+	}{
+		{current: "v0.11.0", target: "v0.11.3"},
+		{current: "v0.11.3", target: "v0.11.1"},
+		{current: "v0.11.3", target: "v0.12.0"},
+		{current: "v0.11.3", target: "v0.12.0-alpha.1"},
+		{current: "v0.12.0-alpha.1-10-g3ea2618-dirty", target: "v0.12.0"},
+		{current: "v0.14.2", target: "v1.0.0"},
+		{current: "v0.12.0", target: "v0.11.5"},
+		{current: "v0.12.0-alpha.1", target: "v0.11.5"},
+		{current: "v0.11.0", target: "latest"},
+		{current: "none", target: "v0.12.0"},
+		{current: "v0.10.4", target: "v0.12.0", expectedError: "upgrade from v0.10.4 to v0.12.0 is not supported, upgrade to v0.11 first"},
+		{current: "v0.14.2", target: "v1.1.0", expectedError: "upgrade from v0.14.2 to v1.1.0 is not supported, upgrade to v0.15 first"},
+		{current: "v0.12.0", target: "v0.10.4", expectedError: "downgrade from v0.12.0 to v0.10.4 is not supported"},
+		{current: "v1.0.0", target: "v0.14.2", expectedError: "downgrade from v1.0.0 to v0.14.2 is not supported"},
+	} {
+		err := version.CheckUpgrade(tt.current, tt.target)
+
+		if tt.expectedError == "" {
+			assert.NoError(t, err, "%s -> %s", tt.current, tt.target)
+		} else {
+			assert.EqualError(t, err, tt.expectedError, "%s -> %s", tt.current, tt.target)
+		}
+	}
+}
+
+func TestParseSemver(t *testing.T) {
+	for _, tt := range []struct {
+		tag      string
+		expected *machineapi.SemanticVersion
+	}{
+		{tag: "v0.12.0", expected: &machineapi.SemanticVersion{Major: 0, Minor: 12, Patch: 0}},
+		{tag: "v0.12.0-alpha.1", expected: &machineapi.SemanticVersion{Major: 0, Minor: 12, PreRelease: "alpha.1"}},
+		{tag: "v1.0.1-beta.0-10-g3ea2618-dirty", expected: &machineapi.SemanticVersion{Major: 1, Patch: 1, PreRelease: "beta.0-10-g3ea2618-dirty"}},
+		{tag: "none"},
+		{tag: ""},
+	} {
+		assert.True(t, proto.Equal(tt.expected, version.ParseSemver(tt.tag)), "%s", tt.tag)
+	}
 }